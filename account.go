@@ -0,0 +1,146 @@
+package albatross
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+var _ Account = (*BasicAccount)(nil)
+var _ Account = (*VestingAccount)(nil)
+var _ Account = (*HtlcAccount)(nil)
+var _ Account = (*StakingAccount)(nil)
+
+// AccountType is the type of an account on the Nimiq 2.0 blockchain
+type AccountType string
+
+const (
+	AccountTypeBasic   AccountType = "basic"
+	AccountTypeVesting AccountType = "vesting"
+	AccountTypeHtlc    AccountType = "htlc"
+	AccountTypeStaking AccountType = "staking"
+)
+
+// Account represents an account on the Nimiq 2.0 blockchain. It is implemented by
+// BasicAccount, VestingAccount, HtlcAccount and StakingAccount, use a type switch
+// to access the fields specific to each type.
+type Account interface {
+	// Base returns the fields common to all accounts
+	Base() *AccountBase
+
+	// Type returns the type of the account
+	Type() AccountType
+}
+
+// AccountBase holds the fields common to all accounts
+type AccountBase struct {
+	Address Address `json:"address"`
+	Balance Luna    `json:"balance"`
+}
+
+// Base returns the account base itself, so it can be embedded to implement Account
+func (a *AccountBase) Base() *AccountBase { return a }
+
+// BasicAccount is an account controlled by a single key pair
+type BasicAccount struct {
+	AccountBase
+}
+
+func (a *BasicAccount) Type() AccountType { return AccountTypeBasic }
+
+// VestingAccount is a contract that releases funds to its owner in steps over time
+type VestingAccount struct {
+	AccountBase
+
+	Owner              Address `json:"owner"`
+	VestingStart       int64   `json:"vestingStart"`    // Unix timestamp in milliseconds from which the funds vest
+	VestingTimeStep    int64   `json:"vestingTimeStep"` // Milliseconds between vesting steps
+	VestingStepAmount  Luna    `json:"vestingStepAmount"`
+	VestingTotalAmount Luna    `json:"vestingTotalAmount"`
+}
+
+func (a *VestingAccount) Type() AccountType { return AccountTypeVesting }
+
+// LockedAmount returns the part of the total amount that has not vested at the given time.
+// A step amount vests after every time step from the start of the contract.
+func (a *VestingAccount) LockedAmount(at time.Time) Luna {
+	elapsed := at.UnixMilli() - a.VestingStart
+	if a.VestingTimeStep <= 0 || a.VestingStepAmount == 0 {
+		if elapsed < 0 {
+			return a.VestingTotalAmount
+		}
+		return 0
+	}
+
+	steps := elapsed / a.VestingTimeStep
+	if steps <= 0 {
+		return a.VestingTotalAmount
+	}
+	vested, err := a.VestingStepAmount.Mul(uint64(steps))
+	if err != nil || vested >= a.VestingTotalAmount {
+		return 0
+	}
+	return a.VestingTotalAmount - vested
+}
+
+// Spendable returns the part of the balance the owner can withdraw at the given time,
+// which is the balance minus the amount that is still locked
+func (a *VestingAccount) Spendable(at time.Time) Luna {
+	locked := a.LockedAmount(at)
+	if a.Balance <= locked {
+		return 0
+	}
+	return a.Balance - locked
+}
+
+// HtlcAccount is a hashed time locked contract, which pays out to the recipient when a
+// pre-image of the hash root is provided, or back to the sender after the timeout
+type HtlcAccount struct {
+	AccountBase
+
+	Sender        Address       `json:"sender"`
+	Recipient     Address       `json:"recipient"`
+	HashRoot      HexBytes      `json:"hashRoot"`
+	HashAlgorithm HashAlgorithm `json:"hashAlgorithm,omitempty"`
+	HashCount     int           `json:"hashCount"`
+	Timeout       int64         `json:"timeout"`
+	TotalAmount   Luna          `json:"totalAmount"`
+}
+
+func (a *HtlcAccount) Type() AccountType { return AccountTypeHtlc }
+
+// StakingAccount is the staking contract holding the stake of all validators and stakers
+type StakingAccount struct {
+	AccountBase
+}
+
+func (a *StakingAccount) Type() AccountType { return AccountTypeStaking }
+
+// UnmarshalAccount decodes an account into the account type given by its type field
+func UnmarshalAccount(data []byte) (Account, error) {
+	var typed struct {
+		Type AccountType `json:"type"`
+	}
+	if err := json.Unmarshal(data, &typed); err != nil {
+		return nil, err
+	}
+
+	var account Account
+	switch typed.Type {
+	case AccountTypeBasic:
+		account = &BasicAccount{}
+	case AccountTypeVesting:
+		account = &VestingAccount{}
+	case AccountTypeHtlc:
+		account = &HtlcAccount{}
+	case AccountTypeStaking:
+		account = &StakingAccount{}
+	default:
+		return nil, fmt.Errorf("unknown account type %q", typed.Type)
+	}
+
+	if err := json.Unmarshal(data, account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
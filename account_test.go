@@ -0,0 +1,111 @@
+package albatross
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalAccounts(t *testing.T) {
+	tests := []struct {
+		json     string
+		expected Account
+	}{
+		{
+			json:     `{"type":"basic","address":"` + testStaker + `","balance":100}`,
+			expected: &BasicAccount{AccountBase{Address: testStakerAddress, Balance: 100}},
+		},
+		{
+			json: `{"type":"vesting","address":"` + testStaker + `","balance":100,"owner":"` + testValidator + `","vestingStart":1000,` +
+				`"vestingTimeStep":60000,"vestingStepAmount":10,"vestingTotalAmount":100}`,
+			expected: &VestingAccount{
+				AccountBase:        AccountBase{Address: testStakerAddress, Balance: 100},
+				Owner:              testValidatorAddress,
+				VestingStart:       1000,
+				VestingTimeStep:    60000,
+				VestingStepAmount:  10,
+				VestingTotalAmount: 100,
+			},
+		},
+		{
+			json: `{"type":"htlc","address":"` + testStaker + `","balance":100,"sender":"` + testValidator + `","recipient":"` + testStaker + `","hashRoot":"aabb",` +
+				`"hashAlgorithm":"sha256","hashCount":2,"timeout":1234,"totalAmount":100}`,
+			expected: &HtlcAccount{
+				AccountBase:   AccountBase{Address: testStakerAddress, Balance: 100},
+				Sender:        testValidatorAddress,
+				Recipient:     testStakerAddress,
+				HashRoot:      HexBytes{0xaa, 0xbb},
+				HashAlgorithm: "sha256",
+				HashCount:     2,
+				Timeout:       1234,
+				TotalAmount:   100,
+			},
+		},
+		{
+			json:     `{"type":"staking","address":"` + testStaker + `","balance":100}`,
+			expected: &StakingAccount{AccountBase{Address: testStakerAddress, Balance: 100}},
+		},
+	}
+
+	for _, test := range tests {
+		account, err := UnmarshalAccount([]byte(test.json))
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, account)
+		assert.Equal(t, test.expected.Type(), account.Type())
+	}
+}
+
+func TestUnmarshalUnknownAccountType(t *testing.T) {
+	_, err := UnmarshalAccount([]byte(`{"type":"unknown"}`))
+	assert.Error(t, err)
+}
+
+func TestGetAccountByAddress(t *testing.T) {
+	client := newMockClient(t, "getAccountByAddress", `["`+testStaker+`"]`, `{"type":"basic","address":"`+testStaker+`","balance":5}`)
+
+	account, err := client.GetAccountByAddress(testStakerAddress)
+	assert.NoError(t, err)
+	assert.Equal(t, AccountTypeBasic, account.Type())
+	assert.Equal(t, Luna(5), account.Base().Balance)
+}
+
+func TestGetAccountByAddressNullResult(t *testing.T) {
+	client := newMockClient(t, "getAccountByAddress", `["`+testStaker+`"]`, `null`)
+
+	_, err := client.GetAccountByAddress(testStakerAddress)
+	assert.Error(t, err)
+}
+
+func TestVestingSpendable(t *testing.T) {
+	account := &VestingAccount{
+		AccountBase:        AccountBase{Balance: 80},
+		VestingStart:       1000,
+		VestingTimeStep:    60000,
+		VestingStepAmount:  10,
+		VestingTotalAmount: 100,
+	}
+
+	tests := []struct {
+		at        int64
+		locked    Luna
+		spendable Luna
+	}{
+		{0, 100, 0},
+		{60999, 100, 0},
+		{61000, 90, 0},
+		{181000, 70, 10},
+		{601000, 0, 80},
+		{1 << 50, 0, 80},
+	}
+
+	for _, test := range tests {
+		at := time.UnixMilli(test.at)
+		assert.Equal(t, test.locked, account.LockedAmount(at), "locked at %d", test.at)
+		assert.Equal(t, test.spendable, account.Spendable(at), "spendable at %d", test.at)
+	}
+
+	account.VestingStepAmount = 0
+	assert.Equal(t, Luna(100), account.LockedAmount(time.UnixMilli(999)))
+	assert.Equal(t, Luna(0), account.LockedAmount(time.UnixMilli(1000)))
+}
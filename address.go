@@ -0,0 +1,164 @@
+package albatross
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// AddressLength is the length of a raw address in bytes
+const AddressLength = 20
+
+// ErrInvalidAddress is returned when an address cannot be parsed
+var ErrInvalidAddress = errors.New("invalid address")
+
+const (
+	addressCountryCode = "NQ"
+	addressAlphabet    = "0123456789ABCDEFGHJKLMNPQRSTUVXY"
+)
+
+// Address is the 20 byte address of an account on the Nimiq blockchain.
+// It is encoded in JSON in the user friendly format, e.g. "NQ07 0000 0000 0000 0000 0000 0000 0000 0000".
+// The zero value is the burn address, which is accepted as recipient but not as sender by the transaction wrappers.
+type Address [AddressLength]byte
+
+// ParseAddress parses an address in the user friendly format, with or without spaces, or in hex format.
+// The checksum of the user friendly format is verified.
+func ParseAddress(s string) (Address, error) {
+	s = strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(s), " ", ""))
+
+	switch {
+	case len(s) == 36 && strings.HasPrefix(s, addressCountryCode):
+		if !isDigit(s[2]) || !isDigit(s[3]) {
+			return Address{}, fmt.Errorf("%w: invalid check digits in %q", ErrInvalidAddress, s)
+		}
+		return parseUserFriendlyAddress(s)
+	case len(s) == 2*AddressLength, len(s) == 2*AddressLength+2 && strings.HasPrefix(s, "0X"):
+		return AddressFromHex(s)
+	}
+
+	return Address{}, fmt.Errorf("%w: %q is neither a user friendly nor a hex address", ErrInvalidAddress, s)
+}
+
+// AddressFromBytes returns the address of a 20 byte raw address
+func AddressFromBytes(b []byte) (Address, error) {
+	var a Address
+	if len(b) != AddressLength {
+		return a, fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidAddress, AddressLength, len(b))
+	}
+	copy(a[:], b)
+	return a, nil
+}
+
+// AddressFromHex returns the address of a hex encoded raw address, with or without 0x prefix
+func AddressFromHex(s string) (Address, error) {
+	b, err := hex.DecodeString(trimHexPrefix(s))
+	if err != nil {
+		return Address{}, fmt.Errorf("%w: %s", ErrInvalidAddress, err)
+	}
+	return AddressFromBytes(b)
+}
+
+// isDigit returns whether c is an ASCII digit
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func parseUserFriendlyAddress(s string) (Address, error) {
+	var a Address
+	var buffer, bits int
+	index := 0
+	for _, c := range s[4:] {
+		value := strings.IndexRune(addressAlphabet, c)
+		if value < 0 {
+			return Address{}, fmt.Errorf("%w: invalid character %q", ErrInvalidAddress, c)
+		}
+
+		buffer = buffer<<5 | value
+		bits += 5
+		if bits >= 8 {
+			bits -= 8
+			a[index] = byte(buffer >> bits)
+			buffer &= 1<<bits - 1
+			index++
+		}
+	}
+
+	// The checksum is valid if the remainder of the rearranged address equals 1, as in IBAN
+	if ibanCheck(s[4:]+s[:4]) != 1 {
+		return Address{}, fmt.Errorf("%w: checksum of %q does not match", ErrInvalidAddress, s)
+	}
+
+	return a, nil
+}
+
+// Bytes returns the raw 20 bytes of the address
+func (a Address) Bytes() []byte {
+	return a[:]
+}
+
+// Hex returns the hex encoded raw address
+func (a Address) Hex() string {
+	return hex.EncodeToString(a[:])
+}
+
+// String returns the address in the user friendly format
+func (a Address) String() string {
+	var base32 strings.Builder
+	var buffer, bits int
+	for _, b := range a {
+		buffer = buffer<<8 | int(b)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			base32.WriteByte(addressAlphabet[buffer>>bits&31])
+		}
+	}
+
+	check := 98 - ibanCheck(base32.String()+addressCountryCode+"00")
+	compact := fmt.Sprintf("%s%02d%s", addressCountryCode, check, base32.String())
+
+	var friendly strings.Builder
+	for i := 0; i < len(compact); i += 4 {
+		if i > 0 {
+			friendly.WriteByte(' ')
+		}
+		friendly.WriteString(compact[i : i+4])
+	}
+	return friendly.String()
+}
+
+// IsZero returns whether the address is the zero value
+func (a Address) IsZero() bool {
+	return a == Address{}
+}
+
+// MarshalText encodes the address in the user friendly format
+func (a Address) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalText decodes an address in the user friendly or hex format
+func (a *Address) UnmarshalText(text []byte) error {
+	parsed, err := ParseAddress(string(text))
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+// ibanCheck returns the remainder of the IBAN representation of s divided by 97.
+// Letters are replaced by two digits, A = 10 to Z = 35.
+func ibanCheck(s string) int {
+	remainder := 0
+	for _, c := range s {
+		if c >= '0' && c <= '9' {
+			remainder = (remainder*10 + int(c-'0')) % 97
+		} else {
+			remainder = (remainder*100 + int(c-'A'+10)) % 97
+		}
+	}
+	return remainder
+}
@@ -0,0 +1,93 @@
+package albatross
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBurnAddress(t *testing.T) {
+	var burn Address
+	assert.Equal(t, "NQ07 0000 0000 0000 0000 0000 0000 0000 0000", burn.String())
+
+	parsed, err := ParseAddress("NQ07 0000 0000 0000 0000 0000 0000 0000 0000")
+	assert.NoError(t, err)
+	assert.True(t, parsed.IsZero())
+}
+
+func TestStakingContractAddress(t *testing.T) {
+	address, err := ParseAddress("NQ38 STAK 1NG0 0000 0000 C0NT RACT 0000 0000")
+	assert.NoError(t, err)
+	assert.Equal(t, "NQ38 STAK 1NG0 0000 0000 C0NT RACT 0000 0000", address.String())
+}
+
+func TestParseAddressFormats(t *testing.T) {
+	expectedHex := "d44b295c41dd43cf041d88718320357fd346e8cc"
+
+	for _, input := range []string{
+		"NQ61 SH5J JP21 TM1U X10V H1QQ 681M FY9L DS6C",
+		"NQ61SH5JJP21TM1UX10VH1QQ681MFY9LDS6C",
+		"nq61 sh5j jp21 tm1u x10v h1qq 681m fy9l ds6c",
+		"d44b295c41dd43cf041d88718320357fd346e8cc",
+		"0xd44b295c41dd43cf041d88718320357fd346e8cc",
+	} {
+		address, err := ParseAddress(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, expectedHex, address.Hex(), input)
+		assert.Equal(t, "NQ61 SH5J JP21 TM1U X10V H1QQ 681M FY9L DS6C", address.String(), input)
+	}
+}
+
+func TestParseInvalidAddress(t *testing.T) {
+	for _, input := range []string{
+		"",
+		"NQ62 SH5J JP21 TM1U X10V H1QQ 681M FY9L DS6C", // wrong check digits
+		"NQ61 SH5J JP21 TM1U X10V H1QQ 681M FY9L DS6D", // wrong character
+		"NQ61 SH5J JP21 TM1U X10V H1QQ 681M FY9L DS6W", // W is not in the alphabet
+		"DE61 SH5J JP21 TM1U X10V H1QQ 681M FY9L DS6C", // wrong country code
+		"NQ61 SH5J JP21 TM1U X10V H1QQ 681M FY9L",
+		"NQ+1 SH5J JP21 TM1U X10V H1QQ 681M FY9L DS6C", // check digits must be two digits
+		"NQ-1 SH5J JP21 TM1U X10V H1QQ 681M FY9L DS6C",
+		"d44b295c41dd43cf041d88718320357fd346e8zz",
+	} {
+		_, err := ParseAddress(input)
+		assert.True(t, errors.Is(err, ErrInvalidAddress), "%q should be invalid", input)
+	}
+}
+
+func TestAddressFromBytes(t *testing.T) {
+	address, err := AddressFromBytes(testStakerAddress.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, testStakerAddress, address)
+
+	_, err = AddressFromBytes(make([]byte, 19))
+	assert.ErrorIs(t, err, ErrInvalidAddress)
+}
+
+func TestAddressJSON(t *testing.T) {
+	data, err := json.Marshal(testStakerAddress)
+	assert.NoError(t, err)
+	assert.Equal(t, `"`+testStaker+`"`, string(data))
+
+	var address Address
+	assert.NoError(t, json.Unmarshal(data, &address))
+	assert.Equal(t, testStakerAddress, address)
+
+	assert.Error(t, json.Unmarshal([]byte(`"NQ00 0000"`), &address))
+}
+
+func TestTransactionWrapperRejectsMissingAddress(t *testing.T) {
+	client := &HttpClient{}
+
+	_, err := client.SendBasicTransaction(&BasicTransactionParams{Recipient: testStakerAddress, Value: 1})
+	assert.EqualError(t, err, "wallet address is required")
+}
+
+func TestTransactionWrapperAcceptsBurnRecipient(t *testing.T) {
+	client := newMockClient(t, "sendBasicTransaction", `["`+testStaker+`","NQ07 0000 0000 0000 0000 0000 0000 0000 0000",1,0,"+0"]`, testTxHash)
+
+	_, err := client.SendBasicTransaction(&BasicTransactionParams{Wallet: testStakerAddress, Value: 1})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,243 @@
+package albatross
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+var _ Block = (*MicroBlock)(nil)
+var _ Block = (*MacroBlock)(nil)
+
+// BlockType is the type of a block, either micro or macro
+type BlockType string
+
+const (
+	BlockTypeMicro BlockType = "micro"
+	BlockTypeMacro BlockType = "macro"
+)
+
+// Block represents a block on the Nimiq 2.0 blockchain. It is implemented by
+// MicroBlock and MacroBlock, use a type switch to access the fields specific to each type.
+type Block interface {
+	// Header returns the fields common to micro and macro blocks
+	Header() *BlockHeader
+
+	// Type returns the type of the block
+	Type() BlockType
+}
+
+// BlockHeader holds the fields common to micro and macro blocks
+type BlockHeader struct {
+	Hash        Hash     `json:"hash"`
+	Size        int      `json:"size"`
+	Number      int      `json:"number"`
+	Epoch       int      `json:"epoch"`
+	Batch       int      `json:"batch"`
+	Version     int      `json:"version"`
+	Timestamp   int64    `json:"timestamp"`
+	ParentHash  Hash     `json:"parentHash"`
+	Seed        HexBytes `json:"seed"`        // VRF seed of the block
+	ExtraData   HexBytes `json:"extraData"`   // Data belonging to the block
+	StateHash   Hash     `json:"stateHash"`   // Root of the accounts tree after the block
+	BodyHash    Hash     `json:"bodyHash"`    // Hash of the block body
+	HistoryHash Hash     `json:"historyHash"` // Root of the history tree after the block
+
+	// Transactions holds either the hashes or the full transactions of the block,
+	// depending on whether full transactions were requested
+	Transactions BlockTransactions `json:"transactions,omitempty"`
+}
+
+// Header returns the header itself, so it can be embedded to implement Block
+func (b *BlockHeader) Header() *BlockHeader { return b }
+
+// MicroBlock is a block produced by a single validator, containing transactions
+type MicroBlock struct {
+	BlockHeader
+
+	// Producer is the slot of the validator that produced the block
+	Producer *Slot `json:"producer"`
+
+	// Justification is the signature of the producer, or the proof of a skip block
+	Justification *MicroJustification `json:"justification,omitempty"`
+}
+
+func (b *MicroBlock) Type() BlockType { return BlockTypeMicro }
+
+// MicroJustification justifies a micro block. Exactly one of its fields is set.
+type MicroJustification struct {
+	Micro string          `json:"micro,omitempty"` // Hex encoded signature of the producer
+	Skip  *SkipBlockProof `json:"skip,omitempty"`  // Proof that the validators agreed to skip the producer
+}
+
+// SkipBlockProof proves that a supermajority of validators agreed to skip a block producer
+type SkipBlockProof struct {
+	Signature MultiSignature `json:"sig"`
+}
+
+// MacroBlock is a block finalizing a batch, signed by the validators through Tendermint.
+// Macro blocks at the end of an epoch are election blocks and contain the slots of the next epoch.
+type MacroBlock struct {
+	BlockHeader
+
+	IsElectionBlock    bool `json:"isElectionBlock"`
+	ParentElectionHash Hash `json:"parentElectionHash"`
+
+	// Slots is only returned in an election block and contains
+	// the slot distribution for the next epoch
+	Slots []Slots `json:"slots,omitempty"`
+
+	// LostRewardSet holds the slots that lost their rewards in the batch
+	LostRewardSet []int `json:"lostRewardSet,omitempty"`
+
+	// DisabledSet holds the slots that are disabled from producing blocks in the batch
+	DisabledSet []int `json:"disabledSet,omitempty"`
+
+	// Justification is the Tendermint proof that finalized the block
+	Justification *TendermintProof `json:"justification,omitempty"`
+}
+
+func (b *MacroBlock) Type() BlockType { return BlockTypeMacro }
+
+// TendermintProof is the aggregated signature of the validators that finalized a macro block
+type TendermintProof struct {
+	Round     int            `json:"round"`
+	Signature MultiSignature `json:"sig"`
+}
+
+// MultiSignature is an aggregated BLS signature of multiple slots
+type MultiSignature struct {
+	Signature HexBytes `json:"signature"` // Aggregated signature
+	Signers   []int    `json:"signers"`   // Slot numbers of the signers
+}
+
+// Slot represents a slot used to produce a micro block
+type Slot struct {
+	SlotNumber int     `json:"slotNumber"`
+	Validator  Address `json:"validator"`
+	PublicKey  string  `json:"publicKey"`
+}
+
+// Slots contain the distribution of slots for a next epoch for a particular validator
+type Slots struct {
+	FirstSlotNumber int     `json:"firstSlotNumber"`
+	NumSlots        int     `json:"numSlots"`
+	Validator       Address `json:"validator"`
+	PublicKey       string  `json:"publicKey"`
+}
+
+// BlockTransactions holds the transactions of a block, which the RPC server returns either
+// as an array of hashes or as an array of full transactions
+type BlockTransactions struct {
+	hashes []Hash
+	full   []*Transaction
+}
+
+// UnmarshalJSON decodes an array of transaction hashes or an array of full transactions
+func (t *BlockTransactions) UnmarshalJSON(data []byte) error {
+	var elements []json.RawMessage
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+
+	t.hashes, t.full = nil, nil
+	for _, element := range elements {
+		element = bytes.TrimSpace(element)
+		if len(element) > 0 && element[0] == '"' {
+			var hash Hash
+			if err := json.Unmarshal(element, &hash); err != nil {
+				return err
+			}
+			t.hashes = append(t.hashes, hash)
+			continue
+		}
+
+		var tx Transaction
+		if err := json.Unmarshal(element, &tx); err != nil {
+			return err
+		}
+		t.full = append(t.full, &tx)
+	}
+
+	if len(t.hashes) > 0 && len(t.full) > 0 {
+		return errors.New("block transactions mix hashes and full transactions")
+	}
+	return nil
+}
+
+// MarshalJSON encodes the transactions in the form they were received
+func (t BlockTransactions) MarshalJSON() ([]byte, error) {
+	if t.full != nil {
+		return json.Marshal(t.full)
+	}
+	if t.hashes != nil {
+		return json.Marshal(t.hashes)
+	}
+	return []byte("[]"), nil
+}
+
+// Len returns the number of transactions in the block
+func (t *BlockTransactions) Len() int {
+	if t.full != nil {
+		return len(t.full)
+	}
+	return len(t.hashes)
+}
+
+// Hashes returns the hashes of the transactions in the block.
+// This works both with and without full transactions.
+func (t *BlockTransactions) Hashes() []Hash {
+	if t.full == nil {
+		return t.hashes
+	}
+
+	hashes := make([]Hash, 0, len(t.full))
+	for _, tx := range t.full {
+		hashes = append(hashes, tx.Hash)
+	}
+	return hashes
+}
+
+// Full returns the full transactions in the block. The boolean is false if the
+// block was retrieved without full transactions, in which case only Hashes is available.
+func (t *BlockTransactions) Full() ([]*Transaction, bool) {
+	if t.full == nil && len(t.hashes) > 0 {
+		return nil, false
+	}
+	return t.full, true
+}
+
+// UnmarshalBlock decodes a block into a MicroBlock or MacroBlock depending on its type
+func UnmarshalBlock(data []byte) (Block, error) {
+	var typed struct {
+		Type BlockType `json:"type"`
+	}
+	if err := json.Unmarshal(data, &typed); err != nil {
+		return nil, err
+	}
+
+	var block Block
+	switch typed.Type {
+	case BlockTypeMicro:
+		block = &MicroBlock{}
+	case BlockTypeMacro:
+		block = &MacroBlock{}
+	default:
+		return nil, fmt.Errorf("unknown block type %q", typed.Type)
+	}
+
+	if err := json.Unmarshal(data, block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// UnwrapBlock takes a type of JsonUnwrapper and returns the wrapped block
+func UnwrapBlock(obj JsonUnwrapper) (Block, error) {
+	if err := obj.GetErr(); err != nil {
+		return nil, err
+	}
+
+	return UnmarshalBlock(obj.GetWrapped())
+}
@@ -0,0 +1,122 @@
+package albatross
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testMicroBlock = `{"hash":"` + testHashA + `","number":5,"epoch":1,"batch":2,"type":"micro","parentHash":"` + testHashB + `",` +
+	`"producer":{"slotNumber":3,"validator":"` + testValidator + `","publicKey":"pk"},"justification":{"micro":"cc"}}`
+
+const testMacroBlock = `{"hash":"` + testHashB + `","number":12,"epoch":1,"batch":3,"type":"macro","isElectionBlock":true,` +
+	`"parentElectionHash":"` + testHashA + `","historyHash":"` + testHashB + `","seed":"ff","extraData":"0102",` +
+	`"slots":[{"firstSlotNumber":0,"numSlots":256,"validator":"` + testValidator + `","publicKey":"pk"}],` +
+	`"lostRewardSet":[1,2],"disabledSet":[2],"justification":{"round":1,"sig":{"signature":"00aa","signers":[0,1,3]}}}`
+
+func TestUnmarshalMicroBlock(t *testing.T) {
+	block, err := UnmarshalBlock([]byte(testMicroBlock))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	micro, ok := block.(*MicroBlock)
+	if !ok {
+		t.Fatalf("Expected a micro block, got %T", block)
+	}
+
+	assert.Equal(t, BlockTypeMicro, block.Type())
+	assert.Equal(t, 5, block.Header().Number)
+	assert.Equal(t, 3, micro.Producer.SlotNumber)
+	assert.Equal(t, "cc", micro.Justification.Micro)
+	assert.Nil(t, micro.Justification.Skip)
+}
+
+func TestUnmarshalMacroBlock(t *testing.T) {
+	block, err := UnmarshalBlock([]byte(testMacroBlock))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	macro, ok := block.(*MacroBlock)
+	if !ok {
+		t.Fatalf("Expected a macro block, got %T", block)
+	}
+
+	assert.True(t, macro.IsElectionBlock)
+	assert.Equal(t, mustParseHash(t, testHashA), macro.ParentElectionHash)
+	assert.Equal(t, mustParseHash(t, testHashB), macro.HistoryHash)
+	assert.True(t, macro.StateHash.IsZero())
+	assert.Equal(t, HexBytes{0xff}, macro.Seed)
+	assert.Equal(t, HexBytes{0x01, 0x02}, macro.ExtraData)
+	assert.Equal(t, []Slots{{FirstSlotNumber: 0, NumSlots: 256, Validator: testValidatorAddress, PublicKey: "pk"}}, macro.Slots)
+	assert.Equal(t, []int{1, 2}, macro.LostRewardSet)
+	assert.Equal(t, []int{2}, macro.DisabledSet)
+	assert.Equal(t, 1, macro.Justification.Round)
+	assert.Equal(t, HexBytes{0x00, 0xaa}, macro.Justification.Signature.Signature)
+	assert.Equal(t, []int{0, 1, 3}, macro.Justification.Signature.Signers)
+}
+
+func TestUnmarshalUnknownBlockType(t *testing.T) {
+	_, err := UnmarshalBlock([]byte(`{"type":"mega"}`))
+	assert.Error(t, err)
+}
+
+func TestGetBlockByNumber(t *testing.T) {
+	client := newMockClient(t, "getBlockByNumber", `[12,false]`, testMacroBlock)
+
+	block, err := client.GetBlockByNumber(12)
+	assert.NoError(t, err)
+	assert.Equal(t, BlockTypeMacro, block.Type())
+	assert.Equal(t, testHashB, block.Header().Hash.String())
+}
+
+func TestBlockTransactionHashes(t *testing.T) {
+	mockResult := `{"type":"micro","number":5,"transactions":["` + testHashA + `","` + testHashB + `"]}`
+	client := newMockClient(t, "getBlockByHash", `["`+testHashB+`",false]`, mockResult)
+
+	block, err := client.GetBlockByHash(mustParseHash(t, testHashB))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	txs := block.Header().Transactions
+	assert.Equal(t, 2, txs.Len())
+	assert.Equal(t, []Hash{mustParseHash(t, testHashA), mustParseHash(t, testHashB)}, txs.Hashes())
+
+	_, ok := txs.Full()
+	assert.False(t, ok, "Full transactions should not be available")
+}
+
+func TestBlockFullTransactions(t *testing.T) {
+	mockResult := `{"type":"micro","number":5,"transactions":[{"hash":"` + testHashA + `","value":100},{"hash":"` + testHashB + `","value":200}]}`
+	client := newMockClient(t, "getLatestBlock", `[true]`, mockResult)
+
+	block, err := client.GetLatestBlock(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	txs := block.Header().Transactions
+	full, ok := txs.Full()
+	assert.True(t, ok)
+	assert.Len(t, full, 2)
+	assert.Equal(t, Luna(200), full[1].Value)
+	assert.Equal(t, []Hash{mustParseHash(t, testHashA), mustParseHash(t, testHashB)}, txs.Hashes())
+}
+
+func TestBlockWithoutTransactions(t *testing.T) {
+	block, err := UnmarshalBlock([]byte(`{"type":"macro","number":12,"transactions":[]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	full, ok := block.Header().Transactions.Full()
+	assert.True(t, ok)
+	assert.Empty(t, full)
+}
+
+func TestBlockTransactionsMixed(t *testing.T) {
+	_, err := UnmarshalBlock([]byte(`{"type":"micro","transactions":["` + testHashA + `",{"hash":"` + testHashB + `"}]}`))
+	assert.Error(t, err)
+}
@@ -5,10 +5,12 @@ go 1.18
 require (
 	github.com/shopspring/decimal v1.3.1
 	github.com/stretchr/testify v1.8.0
+	golang.org/x/crypto v0.17.0
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/sys v0.15.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -1,6 +1,9 @@
 package albatross
 
-import "regexp"
+import (
+	"fmt"
+	"regexp"
+)
 
 func verifyUrl(url string) (bool, error) {
 	regex := `^(https|http|ws|wss):\/\/`
@@ -13,3 +16,40 @@ func addOptionalParam[T any, D any](params []interface{}, optional []T, defaultV
 	}
 	return append(params, defaultValue)
 }
+
+// validateLuna verifies that an amount of Luna is within the total supply.
+// If allowZero is false the amount must be greater than zero.
+func validateLuna(name string, l Luna, allowZero bool) error {
+	if !allowZero && l == 0 {
+		return fmt.Errorf("invalid %s: amount must be greater than zero", name)
+	}
+	if l > MaxLuna {
+		return fmt.Errorf("invalid %s: amount of %d luna exceeds total supply", name, l)
+	}
+	return nil
+}
+
+// requireAddress returns an error for the burn address where an address that sends or signs is
+// required. Nobody holds the key of the burn address, so it is only accepted for recipients.
+func requireAddress(name string, a Address) error {
+	if a.IsZero() {
+		return fmt.Errorf("%s address is required", name)
+	}
+	return nil
+}
+
+// optionalAddress returns nil for the zero address, so it is sent as null to the RPC server
+func optionalAddress(a Address) interface{} {
+	if a.IsZero() {
+		return nil
+	}
+	return a
+}
+
+// optionalString returns nil for an empty string, so it is sent as null to the RPC server
+func optionalString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
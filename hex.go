@@ -0,0 +1,93 @@
+package albatross
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// HashLength is the length of a Blake2b hash in bytes
+const HashLength = 32
+
+// ErrInvalidHash is returned when a hash cannot be parsed
+var ErrInvalidHash = errors.New("invalid hash")
+
+// HexBytes is a byte slice that is hex encoded in JSON, as used by the RPC server
+type HexBytes []byte
+
+// String returns the hex encoded bytes
+func (b HexBytes) String() string {
+	return hex.EncodeToString(b)
+}
+
+// MarshalText encodes the bytes as hex
+func (b HexBytes) MarshalText() ([]byte, error) {
+	return []byte(hex.EncodeToString(b)), nil
+}
+
+// UnmarshalText decodes hex encoded bytes, with or without 0x prefix
+func (b *HexBytes) UnmarshalText(text []byte) error {
+	decoded, err := hex.DecodeString(trimHexPrefix(string(text)))
+	if err != nil {
+		return err
+	}
+	*b = decoded
+	return nil
+}
+
+// Hash is a 32 byte Blake2b hash, which is hex encoded in JSON
+type Hash [HashLength]byte
+
+// ParseHash parses a hex encoded hash, with or without 0x prefix
+func ParseHash(s string) (Hash, error) {
+	b, err := hex.DecodeString(trimHexPrefix(strings.TrimSpace(s)))
+	if err != nil {
+		return Hash{}, fmt.Errorf("%w: %s", ErrInvalidHash, err)
+	}
+	return HashFromBytes(b)
+}
+
+// HashFromBytes returns the hash of a 32 byte slice
+func HashFromBytes(b []byte) (Hash, error) {
+	var h Hash
+	if len(b) != HashLength {
+		return h, fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidHash, HashLength, len(b))
+	}
+	copy(h[:], b)
+	return h, nil
+}
+
+// Bytes returns the raw 32 bytes of the hash
+func (h Hash) Bytes() []byte {
+	return h[:]
+}
+
+// String returns the hex encoded hash
+func (h Hash) String() string {
+	return hex.EncodeToString(h[:])
+}
+
+// IsZero returns whether the hash is the zero value
+func (h Hash) IsZero() bool {
+	return h == Hash{}
+}
+
+// MarshalText encodes the hash as hex
+func (h Hash) MarshalText() ([]byte, error) {
+	return []byte(h.String()), nil
+}
+
+// UnmarshalText decodes a hex encoded hash
+func (h *Hash) UnmarshalText(text []byte) error {
+	parsed, err := ParseHash(string(text))
+	if err != nil {
+		return err
+	}
+	*h = parsed
+	return nil
+}
+
+func trimHexPrefix(s string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+}
@@ -0,0 +1,69 @@
+package albatross
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testHashA = "21cfba017cf06251846eb5085e52a2388b2c4c05bd1b155063358ea63f75ac53"
+	testHashB = "9f5c2a0b8e6f7d4c3b2a1908f7e6d5c4b3a29180f7e6d5c4b3a29180f7e6d5c4"
+)
+
+func mustParseHash(t *testing.T, s string) Hash {
+	t.Helper()
+	h, err := ParseHash(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func TestParseHash(t *testing.T) {
+	h, err := ParseHash(testHashA)
+	assert.NoError(t, err)
+	assert.Equal(t, testHashA, h.String())
+
+	prefixed, err := ParseHash("0x" + testHashA)
+	assert.NoError(t, err)
+	assert.Equal(t, h, prefixed)
+
+	for _, invalid := range []string{"", "aabb", testHashA + "00", "zz" + testHashA[2:]} {
+		_, err := ParseHash(invalid)
+		assert.True(t, errors.Is(err, ErrInvalidHash), "Expected %q to be an invalid hash", invalid)
+	}
+}
+
+func TestHashJSON(t *testing.T) {
+	h := mustParseHash(t, testHashA)
+
+	data, err := json.Marshal(h)
+	assert.NoError(t, err)
+	assert.Equal(t, `"`+testHashA+`"`, string(data))
+
+	var decoded Hash
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, h, decoded)
+	assert.False(t, decoded.IsZero())
+
+	assert.Error(t, json.Unmarshal([]byte(`"aabb"`), &decoded))
+}
+
+func TestHexBytesJSON(t *testing.T) {
+	var b HexBytes
+	assert.NoError(t, json.Unmarshal([]byte(`"0xaabb"`), &b))
+	assert.Equal(t, HexBytes{0xaa, 0xbb}, b)
+
+	data, err := json.Marshal(b)
+	assert.NoError(t, err)
+	assert.Equal(t, `"aabb"`, string(data))
+
+	var empty HexBytes
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &empty))
+	assert.Nil(t, empty)
+
+	assert.Error(t, json.Unmarshal([]byte(`"abc"`), &b))
+}
@@ -0,0 +1,130 @@
+package albatross
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// ErrPreImageMismatch is returned when a pre-image does not hash to the hash root of an HTLC
+var ErrPreImageMismatch = errors.New("pre-image does not match hash root")
+
+// HashAlgorithm is the algorithm of the hash lock of an HTLC
+type HashAlgorithm string
+
+const (
+	HashAlgorithmBlake2b HashAlgorithm = "blake2b"
+	HashAlgorithmSha256  HashAlgorithm = "sha256"
+	HashAlgorithmSha512  HashAlgorithm = "sha512"
+)
+
+// Size returns the size of hashes of the algorithm in bytes, which is also the size of pre-images
+func (a HashAlgorithm) Size() int {
+	switch a {
+	case HashAlgorithmBlake2b, HashAlgorithmSha256:
+		return 32
+	case HashAlgorithmSha512:
+		return 64
+	}
+	return 0
+}
+
+// Validate returns an error if the algorithm is not supported by HTLCs
+func (a HashAlgorithm) Validate() error {
+	if a.Size() == 0 {
+		return fmt.Errorf("unsupported hash algorithm %q", a)
+	}
+	return nil
+}
+
+// Hash returns the hash of data
+func (a HashAlgorithm) Hash(data []byte) ([]byte, error) {
+	switch a {
+	case HashAlgorithmBlake2b:
+		hash := blake2b.Sum256(data)
+		return hash[:], nil
+	case HashAlgorithmSha256:
+		hash := sha256.Sum256(data)
+		return hash[:], nil
+	case HashAlgorithmSha512:
+		hash := sha512.Sum512(data)
+		return hash[:], nil
+	}
+	return nil, a.Validate()
+}
+
+// GeneratePreImage returns a random pre-image of the size of the algorithm's hashes
+func GeneratePreImage(algorithm HashAlgorithm) (HexBytes, error) {
+	if err := algorithm.Validate(); err != nil {
+		return nil, err
+	}
+
+	preImage := make(HexBytes, algorithm.Size())
+	if _, err := io.ReadFull(rand.Reader, preImage); err != nil {
+		return nil, fmt.Errorf("generating pre-image: %w", err)
+	}
+	return preImage, nil
+}
+
+// HashChain hashes the pre-image count times and returns all links of the chain, starting with
+// the pre-image. The last link is the hash root, and revealing the link at index i allows
+// the recipient to redeem with a hash count of i.
+func HashChain(algorithm HashAlgorithm, preImage HexBytes, count int) ([]HexBytes, error) {
+	if err := algorithm.Validate(); err != nil {
+		return nil, err
+	}
+	if len(preImage) != algorithm.Size() {
+		return nil, fmt.Errorf("pre-image for %s must have %d bytes, got %d", algorithm, algorithm.Size(), len(preImage))
+	}
+	if count < 1 {
+		return nil, fmt.Errorf("hash count must be at least 1, got %d", count)
+	}
+
+	chain := make([]HexBytes, count+1)
+	chain[0] = preImage
+	for i := 1; i <= count; i++ {
+		hash, err := algorithm.Hash(chain[i-1])
+		if err != nil {
+			return nil, err
+		}
+		chain[i] = hash
+	}
+	return chain, nil
+}
+
+// HashRoot returns the hash root of a pre-image hashed count times, as passed to the HTLC
+// creation calls together with count and the algorithm
+func HashRoot(algorithm HashAlgorithm, preImage HexBytes, count int) (HexBytes, error) {
+	chain, err := HashChain(algorithm, preImage, count)
+	if err != nil {
+		return nil, err
+	}
+	return chain[count], nil
+}
+
+// VerifyPreImage returns an error if the pre-image hashed count times does not equal the hash root
+func VerifyPreImage(algorithm HashAlgorithm, preImage, hashRoot HexBytes, count int) error {
+	root, err := HashRoot(algorithm, preImage, count)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(root, hashRoot) {
+		return ErrPreImageMismatch
+	}
+	return nil
+}
+
+// VerifyPreImage returns an error if the pre-image hashed hashCount times does not match the hash
+// root of the contract. A hash count lower than the one of the contract redeems only part of it.
+func (a *HtlcAccount) VerifyPreImage(preImage HexBytes, hashCount int) error {
+	if hashCount > a.HashCount {
+		return fmt.Errorf("hash count %d exceeds the hash count %d of the contract", hashCount, a.HashCount)
+	}
+	return VerifyPreImage(a.HashAlgorithm, preImage, a.HashRoot, hashCount)
+}
@@ -0,0 +1,88 @@
+package albatross
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// The expected hashes were computed independently with Python's hashlib
+func TestHashRoot(t *testing.T) {
+	tests := []struct {
+		algorithm HashAlgorithm
+		count     int
+		expected  string
+	}{
+		{HashAlgorithmBlake2b, 1, "89eb0d6a8a691dae2cd15ed0369931ce0a949ecafa5c3f93f8121833646e15c3"},
+		{HashAlgorithmSha256, 1, "66687aadf862bd776c8fc18b8e9f8e20089714856ee233b3902a591d0d5f2925"},
+		{HashAlgorithmSha256, 2, "2b32db6c2c0a6235fb1397e8225ea85e0f0e6e8c7b126d0016ccbde0e667151e"},
+		{HashAlgorithmSha512, 1, "7be9fda48f4179e611c698a73cff09faf72869431efee6eaad14de0cb44bbf66" +
+			"503f752b7a8eb17083355f3ce6eb7d2806f236b25af96a24e22b887405c20081"},
+	}
+
+	for _, test := range tests {
+		preImage := make(HexBytes, test.algorithm.Size())
+		root, err := HashRoot(test.algorithm, preImage, test.count)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, root.String(), "%s with count %d", test.algorithm, test.count)
+	}
+}
+
+func TestHashChain(t *testing.T) {
+	preImage, err := GeneratePreImage(HashAlgorithmSha256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, preImage, 32)
+
+	chain, err := HashChain(HashAlgorithmSha256, preImage, 3)
+	assert.NoError(t, err)
+	assert.Len(t, chain, 4)
+	assert.Equal(t, preImage, chain[0])
+
+	// Every link of the chain redeems with its position as hash count
+	for i, link := range chain[:3] {
+		assert.NoError(t, VerifyPreImage(HashAlgorithmSha256, link, chain[3], 3-i))
+	}
+
+	_, err = HashChain(HashAlgorithmSha256, preImage, 0)
+	assert.Error(t, err)
+	_, err = HashChain(HashAlgorithmSha512, preImage, 1)
+	assert.Error(t, err, "Pre-images must have the size of the algorithm's hashes")
+	_, err = GeneratePreImage("md5")
+	assert.Error(t, err)
+}
+
+func TestHtlcAccountVerifyPreImage(t *testing.T) {
+	preImage, _ := GeneratePreImage(HashAlgorithmBlake2b)
+	root, _ := HashRoot(HashAlgorithmBlake2b, preImage, 2)
+
+	account := &HtlcAccount{HashRoot: root, HashAlgorithm: HashAlgorithmBlake2b, HashCount: 2}
+	assert.NoError(t, account.VerifyPreImage(preImage, 2))
+
+	other, _ := GeneratePreImage(HashAlgorithmBlake2b)
+	assert.True(t, errors.Is(account.VerifyPreImage(other, 2), ErrPreImageMismatch))
+	assert.Error(t, account.VerifyPreImage(preImage, 3))
+}
+
+func TestCreateHtlcWithHashRoot(t *testing.T) {
+	preImage := make(HexBytes, 32)
+	root, _ := HashRoot(HashAlgorithmSha256, preImage, 1)
+
+	params := `["` + testStaker + `","` + testStaker + `","` + testValidator + `",` +
+		`"66687aadf862bd776c8fc18b8e9f8e20089714856ee233b3902a591d0d5f2925",1,"sha256",100,10,0,"+0"]`
+	client := newMockClient(t, "createNewHtlcTransaction", params, `"00"`)
+
+	_, err := client.CreateNewHtlcTransaction(&NewHtlcParams{
+		Wallet:        testStakerAddress,
+		HtlcSender:    testStakerAddress,
+		HtlcRecipient: testValidatorAddress,
+		HashRoot:      root,
+		HashCount:     1,
+		HashAlgorithm: HashAlgorithmSha256,
+		Timeout:       100,
+		Value:         10,
+	})
+	assert.NoError(t, err)
+}
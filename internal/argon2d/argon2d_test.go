@@ -0,0 +1,32 @@
+package argon2d
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// Test vector for Argon2d of RFC 9106, section 5.1
+func TestRFC9106Vector(t *testing.T) {
+	password := bytes.Repeat([]byte{0x01}, 32)
+	salt := bytes.Repeat([]byte{0x02}, 16)
+	secret := bytes.Repeat([]byte{0x03}, 8)
+	data := bytes.Repeat([]byte{0x04}, 12)
+
+	tag := deriveKey(argon2d, password, salt, secret, data, 3, 32, 4, 32)
+
+	expected := "512b391b6f1162975371d30919734294f868e3be3984f3c1a13a4db9fabe4acb"
+	if got := hex.EncodeToString(tag); got != expected {
+		t.Errorf("Expected tag %s, got %s", expected, got)
+	}
+}
+
+func TestKey(t *testing.T) {
+	key := Key([]byte("password"), []byte("somesaltsomesalt"), 1, 512, 1, 40)
+	if len(key) != 40 {
+		t.Errorf("Expected a key of 40 bytes, got %d", len(key))
+	}
+	if bytes.Equal(key, Key([]byte("password"), []byte("othersaltothersa"), 1, 512, 1, 40)) {
+		t.Error("Keys derived with different salts should differ")
+	}
+}
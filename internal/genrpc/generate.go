@@ -0,0 +1,357 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"go/token"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// generator emits the Go source of the client for an OpenRPC document
+type generator struct {
+	doc *Document
+	buf bytes.Buffer
+
+	// paramStructs holds the methods using each parameter struct, keyed by struct name
+	paramStructs     map[string][]*Method
+	paramStructOrder []string
+}
+
+// generate returns the formatted Go source of the client for the given OpenRPC document and
+// overlay with Go mappings
+func generate(schema, overlay []byte) ([]byte, error) {
+	doc, err := parseDocument(schema, overlay)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{
+		doc:          doc,
+		paramStructs: make(map[string][]*Method),
+	}
+
+	g.printf("// Code generated by genrpc from openrpc.json and openrpc-overlay.json. DO NOT EDIT.\n\n")
+	g.printf("package albatross\n")
+
+	for _, method := range doc.Methods {
+		if method.Handwritten {
+			continue
+		}
+		if err := g.method(method); err != nil {
+			return nil, fmt.Errorf("method %s: %w", method.Name, err)
+		}
+	}
+
+	for _, name := range g.paramStructOrder {
+		if err := g.paramStruct(name, g.paramStructs[name]); err != nil {
+			return nil, fmt.Errorf("params %s: %w", name, err)
+		}
+	}
+
+	names := make([]string, 0, len(doc.Components.Schemas))
+	for name := range doc.Components.Schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		schema := doc.Components.Schemas[name]
+		if schema.GoType != "" {
+			continue
+		}
+		if err := g.component(name, schema); err != nil {
+			return nil, fmt.Errorf("component %s: %w", name, err)
+		}
+	}
+
+	return format.Source(g.buf.Bytes())
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// method emits the wrapper of a single RPC method
+func (g *generator) method(m *Method) error {
+	goName := m.GoName
+	if goName == "" {
+		goName = exported(m.Name)
+	}
+
+	results, call, err := g.result(m.Result)
+	if err != nil {
+		return err
+	}
+
+	g.printf("\n// %s %s\n", goName, m.Summary)
+
+	if m.GoParams != "" {
+		if err := g.useParamStruct(m); err != nil {
+			return err
+		}
+
+		resultType := strings.TrimSuffix(strings.TrimPrefix(results, "("), ", error)")
+		g.printf("func (h *HttpClient) %s(p *%s) %s {\n", goName, m.GoParams, results)
+		if call == "callAndConfirm" {
+			g.printf("_, err := callWithParams[interface{}](h, %q, p)\nreturn err\n}\n", m.Name)
+		} else {
+			g.printf("return callWithParams[%s](h, %q, p)\n}\n", resultType, m.Name)
+		}
+		return nil
+	}
+
+	args := make([]string, 0, len(m.Params))
+	values := make([]string, 0, len(m.Params))
+	var optional *Param
+	for i, param := range m.Params {
+		goType, err := g.goType(param.Schema)
+		if err != nil {
+			return err
+		}
+
+		name := identifier(param.Name)
+		switch {
+		case param.Required:
+			args = append(args, name+" "+goType)
+			values = append(values, name)
+		case i == len(m.Params)-1:
+			args = append(args, name+" ..."+goType)
+			optional = param
+		default:
+			args = append(args, name+" *"+goType)
+			values = append(values, name)
+		}
+	}
+
+	g.printf("func (h *HttpClient) %s(%s) %s {\n", goName, strings.Join(args, ", "), results)
+	if optional != nil {
+		goType, _ := g.goType(optional.Schema)
+		g.printf("params := []interface{}{%s}\n", strings.Join(values, ", "))
+		g.printf("params = addOptionalParam[%s, interface{}](params, %s, nil)\n", goType, identifier(optional.Name))
+		g.printf("req := NewRPCRequest(%q, params...)\n\n", m.Name)
+	} else {
+		g.printf("req := NewRPCRequest(%s)\n\n", strings.Join(append([]string{fmt.Sprintf("%q", m.Name)}, values...), ", "))
+	}
+	g.printf("return %s(h, req)\n}\n", call)
+
+	return nil
+}
+
+// result returns the result list of the Go method and the call used to unwrap the result
+func (g *generator) result(result *Param) (string, string, error) {
+	if result == nil || result.Schema == nil || result.Schema.Type == "null" {
+		return "error", "callAndConfirm", nil
+	}
+
+	goType, err := g.goType(result.Schema)
+	if err != nil {
+		return "", "", err
+	}
+
+	if g.isObject(result.Schema) {
+		return fmt.Sprintf("(*%s, error)", goType), fmt.Sprintf("callAndUnwrapToPointer[%s]", goType), nil
+	}
+	return fmt.Sprintf("(%s, error)", goType), fmt.Sprintf("callAndUnwrap[%s]", goType), nil
+}
+
+// useParamStruct registers the parameter struct of a method, verifying that methods
+// sharing a struct have the same parameters
+func (g *generator) useParamStruct(m *Method) error {
+	methods, ok := g.paramStructs[m.GoParams]
+	if !ok {
+		g.paramStructOrder = append(g.paramStructOrder, m.GoParams)
+	} else {
+		a, _ := json.Marshal(methods[0].Params)
+		b, _ := json.Marshal(m.Params)
+		if !bytes.Equal(a, b) {
+			return fmt.Errorf("parameters differ from %s which shares %s", methods[0].Name, m.GoParams)
+		}
+	}
+
+	g.paramStructs[m.GoParams] = append(methods, m)
+	return nil
+}
+
+// paramStruct emits a parameter struct and the method returning its positional parameters
+func (g *generator) paramStruct(name string, methods []*Method) error {
+	names := make([]string, 0, len(methods))
+	for _, m := range methods {
+		names = append(names, m.Name)
+	}
+
+	m := methods[0]
+	g.printf("\n// %s holds the parameters of %s\n", name, strings.Join(names, " and "))
+	g.printf("type %s struct {\n", name)
+
+	fields := make([]string, 0, len(m.Params))
+	for _, param := range m.Params {
+		goType, err := g.goType(param.Schema)
+		if err != nil {
+			return err
+		}
+		if !param.Required {
+			goType = "*" + goType
+		}
+
+		field := exported(param.Name)
+		fields = append(fields, "p."+field)
+		g.printf("%s %s%s\n", field, goType, comment(param.Description))
+	}
+	g.printf("}\n\n")
+
+	g.printf("func (p *%s) params() ([]interface{}, error) {\n", name)
+	validated := false
+	for _, param := range m.Params {
+		if !param.Required {
+			continue
+		}
+		switch param.Schema.GoType {
+		case "Address":
+			if param.AllowBurn {
+				continue
+			}
+			g.printf("if err := requireAddress(%q, p.%s); err != nil {\nreturn nil, err\n}\n", words(param.Name), exported(param.Name))
+			validated = true
+		case "Luna":
+			allowZero := param.Schema.Minimum == nil || *param.Schema.Minimum <= 0
+			g.printf("if err := validateLuna(%q, p.%s, %t); err != nil {\nreturn nil, err\n}\n", words(param.Name), exported(param.Name), allowZero)
+			validated = true
+		}
+	}
+	if validated {
+		g.printf("\n")
+	}
+	g.printf("return []interface{}{\n%s,\n}, nil\n}\n", strings.Join(fields, ",\n"))
+
+	return nil
+}
+
+// component emits the struct of an object schema
+func (g *generator) component(name string, s *Schema) error {
+	if s.Type != "object" || len(s.Properties) == 0 {
+		return fmt.Errorf("only objects with properties can be generated")
+	}
+
+	g.printf("\n// %s %s\n", name, s.Description)
+	g.printf("type %s struct {\n", name)
+	for _, prop := range s.Properties {
+		goType, err := g.goType(prop.Schema)
+		if err != nil {
+			return fmt.Errorf("property %s: %w", prop.Name, err)
+		}
+		if g.isObject(prop.Schema) {
+			goType = "*" + goType
+		}
+
+		tag := prop.Name
+		if !s.isRequired(prop.Name) {
+			tag += ",omitempty"
+		}
+		g.printf("%s %s `json:%q`%s\n", exported(prop.Name), goType, tag, comment(prop.Schema.Description))
+	}
+	g.printf("}\n")
+
+	return nil
+}
+
+// goType returns the Go type of a schema
+func (g *generator) goType(s *Schema) (string, error) {
+	if s.GoType != "" {
+		return s.GoType, nil
+	}
+
+	if s.Ref != "" {
+		component, ok := g.doc.Components.Schemas[s.refName()]
+		if !ok {
+			return "", fmt.Errorf("unknown reference %s", s.Ref)
+		}
+		if component.GoType != "" {
+			return component.GoType, nil
+		}
+		return s.refName(), nil
+	}
+
+	switch s.Type {
+	case "string":
+		return "string", nil
+	case "integer":
+		return "int", nil
+	case "number":
+		return "float64", nil
+	case "boolean":
+		return "bool", nil
+	case "array":
+		if s.Items == nil {
+			return "", fmt.Errorf("array without items")
+		}
+		elem, err := g.goType(s.Items)
+		if err != nil {
+			return "", err
+		}
+		if g.isObject(s.Items) {
+			elem = "*" + elem
+		}
+		return "[]" + elem, nil
+	case "object":
+		if s.AdditionalProperties != nil {
+			elem, err := g.goType(s.AdditionalProperties)
+			if err != nil {
+				return "", err
+			}
+			return "map[string]" + elem, nil
+		}
+	}
+
+	return "", fmt.Errorf("unsupported schema type %q", s.Type)
+}
+
+// isObject returns whether a schema references an object component
+func (g *generator) isObject(s *Schema) bool {
+	if s.Ref == "" {
+		return false
+	}
+	component, ok := g.doc.Components.Schemas[s.refName()]
+	return ok && component.Type == "object"
+}
+
+// exported returns the exported Go name of a camelCase name
+func exported(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// words returns a camelCase name as lower case words, without a trailing "address"
+// as it is part of the error messages of addresses
+func words(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) && i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return strings.TrimSuffix(b.String(), " address")
+}
+
+// identifier returns a valid Go identifier for a parameter name
+func identifier(name string) string {
+	switch {
+	case token.IsKeyword(name), name == "h", name == "p", name == "req", name == "params":
+		return name + "_"
+	}
+	return name
+}
+
+// comment returns a trailing line comment for a description
+func comment(description string) string {
+	if description == "" {
+		return ""
+	}
+	return " // " + description
+}
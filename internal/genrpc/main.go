@@ -0,0 +1,41 @@
+// Command genrpc generates the wrappers of the Albatross RPC methods from the OpenRPC
+// document of the node. The Go mappings of the document, such as the Go types of parameters,
+// are read from a separate overlay, so the document itself is kept as the node publishes it.
+// Methods and types marked as handwritten in the overlay are skipped.
+//
+// Usage:
+//
+//	genrpc -schema openrpc.json -overlay openrpc-overlay.json -out rpc_generated.go
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+)
+
+func main() {
+	schemaPath := flag.String("schema", "openrpc.json", "path to the OpenRPC document")
+	overlayPath := flag.String("overlay", "openrpc-overlay.json", "path to the Go mappings of the document")
+	outPath := flag.String("out", "rpc_generated.go", "path of the generated Go file")
+	flag.Parse()
+
+	schema, err := ioutil.ReadFile(*schemaPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	overlay, err := ioutil.ReadFile(*overlayPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	source, err := generate(schema, overlay)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile(*outPath, source, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func readDocument(t *testing.T) (schema, overlay []byte) {
+	schema, err := ioutil.ReadFile("../../openrpc.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	overlay, err = ioutil.ReadFile("../../openrpc-overlay.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return schema, overlay
+}
+
+// TestGeneratedCodeUpToDate fails when rpc_generated.go drifts from openrpc.json and its overlay.
+// Run `go generate` in the root of the repository to update the generated code.
+func TestGeneratedCodeUpToDate(t *testing.T) {
+	schema, overlay := readDocument(t)
+
+	committed, err := ioutil.ReadFile("../../rpc_generated.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	generated, err := generate(schema, overlay)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(generated) != string(committed) {
+		t.Fatal("rpc_generated.go is out of date with openrpc.json, run go generate")
+	}
+}
+
+// TestMethodsCovered fails when a method of the node document is called by neither the generated
+// nor the handwritten wrappers, e.g. when it is marked handwritten without being implemented.
+func TestMethodsCovered(t *testing.T) {
+	schema, overlay := readDocument(t)
+
+	doc, err := parseDocument(schema, overlay)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	called := calledMethods(t, "../..")
+	for _, method := range doc.Methods {
+		if !called[method.Name] {
+			t.Errorf("method %s of openrpc.json has no wrapper", method.Name)
+		}
+	}
+}
+
+// calledMethods returns the RPC methods the package in dir calls, found as the method argument
+// of NewRPCRequest, NewRPCRequestWithID and callWithParams
+func calledMethods(t *testing.T, dir string) map[string]bool {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	called := make(map[string]bool)
+	for _, pkg := range pkgs {
+		for name, file := range pkg.Files {
+			if strings.HasSuffix(name, "_test.go") {
+				continue
+			}
+
+			ast.Inspect(file, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+
+				fun := call.Fun
+				if index, ok := fun.(*ast.IndexExpr); ok {
+					fun = index.X
+				}
+				ident, ok := fun.(*ast.Ident)
+				if !ok {
+					return true
+				}
+
+				arg := -1
+				switch ident.Name {
+				case "NewRPCRequest", "NewRPCRequestWithID":
+					arg = 0
+				case "callWithParams":
+					arg = 1
+				}
+				if arg < 0 || len(call.Args) <= arg {
+					return true
+				}
+
+				if lit, ok := call.Args[arg].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+					if method, err := strconv.Unquote(lit.Value); err == nil {
+						called[method] = true
+					}
+				}
+				return true
+			})
+		}
+	}
+
+	return called
+}
+
+func TestGenerateMethod(t *testing.T) {
+	schema := `{"methods":[{"name":"getThing","summary":"returns the thing","params":[
+		{"name":"type","required":true,"schema":{"type":"string"}},
+		{"name":"max","required":false,"schema":{"type":"integer"}}],
+		"result":{"name":"result","schema":{"$ref":"#/components/schemas/Thing"}}}],
+		"components":{"schemas":{"Thing":{"type":"object","description":"is a thing","required":["value"],"properties":{
+		"value":{"type":"integer"},"tags":{"type":"array","items":{"type":"string"}}}}}}}`
+	overlay := `{"components":{"schemas":{"Thing":{"properties":{"value":{"x-go-type":"Luna"}}}}}}`
+
+	source, err := generate([]byte(schema), []byte(overlay))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Contains(t, string(source), "func (h *HttpClient) GetThing(type_ string, max ...int) (*Thing, error) {")
+	assert.Contains(t, string(source), "params = addOptionalParam[int, interface{}](params, max, nil)")
+	assert.Contains(t, string(source), "Value Luna     `json:\"value\"`")
+	assert.Contains(t, string(source), "Tags  []string `json:\"tags,omitempty\"`")
+}
+
+func TestGenerateUnknownReference(t *testing.T) {
+	schema := `{"methods":[{"name":"getThing","summary":"returns the thing","params":[],
+		"result":{"name":"result","schema":{"$ref":"#/components/schemas/Missing"}}}]}`
+
+	_, err := generate([]byte(schema), nil)
+	assert.Error(t, err)
+}
+
+func TestGenerateSharedParamsMustMatch(t *testing.T) {
+	schema := `{"methods":[
+		{"name":"createThing","summary":"creates","params":[{"name":"a","required":true,"schema":{"type":"string"}}],"result":{"name":"result","schema":{"type":"string"}}},
+		{"name":"sendThing","summary":"sends","params":[{"name":"b","required":true,"schema":{"type":"string"}}],"result":{"name":"result","schema":{"type":"string"}}}]}`
+	overlay := `{"methods":{"createThing":{"x-go-params":"ThingParams"},"sendThing":{"x-go-params":"ThingParams"}}}`
+
+	_, err := generate([]byte(schema), []byte(overlay))
+	assert.Error(t, err)
+}
+
+func TestOverlayMustMatchDocument(t *testing.T) {
+	schema := `{"methods":[{"name":"getThing","summary":"returns the thing","params":[
+		{"name":"type","required":true,"schema":{"type":"string"}}],"result":{"name":"result","schema":{"type":"string"}}}]}`
+
+	_, err := generate([]byte(schema), []byte(`{"methods":{"getOther":{"x-go-handwritten":true}}}`))
+	assert.EqualError(t, err, "overlay: method getOther is not in the document")
+
+	_, err = generate([]byte(schema), []byte(`{"methods":{"getThing":{"params":{"max":{"schema":{"x-go-type":"Luna"}}}}}}`))
+	assert.EqualError(t, err, "overlay: method getThing: param max is not in the document")
+}
+
+func TestOverlayHandwrittenMethod(t *testing.T) {
+	schema := `{"methods":[{"name":"getThing","summary":"returns the thing","params":[],"result":{"name":"result","schema":{"type":"string"}}}]}`
+
+	source, err := generate([]byte(schema), []byte(`{"methods":{"getThing":{"x-go-handwritten":true}}}`))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(source), "GetThing")
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Document is the subset of an OpenRPC document used to generate the client
+type Document struct {
+	OpenRPC    string     `json:"openrpc"`
+	Methods    []*Method  `json:"methods"`
+	Components Components `json:"components"`
+}
+
+// Components holds the reusable schemas referenced by the methods
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+// Method describes a single RPC method
+type Method struct {
+	Name    string   `json:"name"`
+	Summary string   `json:"summary"`
+	Params  []*Param `json:"params"`
+	Result  *Param   `json:"result"`
+
+	// GoName overrides the name of the generated Go method, set by x-go-name of the overlay
+	GoName string `json:"-"`
+	// GoParams is the name of the parameter struct shared by methods with the same parameters,
+	// set by x-go-params of the overlay. Methods without it take their parameters as arguments.
+	GoParams string `json:"-"`
+	// Handwritten marks methods that are implemented by hand and skipped by the generator,
+	// set by x-go-handwritten of the overlay
+	Handwritten bool `json:"-"`
+}
+
+// Param describes a parameter or the result of a method
+type Param struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Required    bool    `json:"required"`
+	Schema      *Schema `json:"schema"`
+
+	// AllowBurn marks addresses that only receive funds, so the burn address is a valid value.
+	// It is set by x-go-allow-burn of the overlay.
+	AllowBurn bool `json:"-"`
+}
+
+// Schema is the subset of JSON schema used in the document
+type Schema struct {
+	Ref                  string            `json:"$ref"`
+	Type                 string            `json:"type"`
+	Description          string            `json:"description"`
+	Items                *Schema           `json:"items"`
+	Properties           orderedProperties `json:"properties"`
+	AdditionalProperties *Schema           `json:"additionalProperties"`
+	Required             []string          `json:"required"`
+	Minimum              *int64            `json:"minimum"`
+
+	// GoType maps the schema to an existing Go type, set by x-go-type of the overlay.
+	// Components with a GoType are implemented by hand and are not generated.
+	GoType string `json:"-"`
+}
+
+// isRequired returns whether the given property of an object schema is required
+func (s *Schema) isRequired(property string) bool {
+	for _, name := range s.Required {
+		if name == property {
+			return true
+		}
+	}
+	return false
+}
+
+// refName returns the name of the component a schema references
+func (s *Schema) refName() string {
+	return strings.TrimPrefix(s.Ref, "#/components/schemas/")
+}
+
+// property is a named property of an object schema
+type property struct {
+	Name   string
+	Schema *Schema
+}
+
+// orderedProperties decodes the properties of an object schema in the order of the document,
+// so generated structs follow the field order of the schema
+type orderedProperties []property
+
+func (o *orderedProperties) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	if _, err := decoder.Token(); err != nil {
+		return err
+	}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		var schema Schema
+		if err := decoder.Decode(&schema); err != nil {
+			return err
+		}
+
+		*o = append(*o, property{Name: token.(string), Schema: &schema})
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Overlay holds the Go mappings of an OpenRPC document. It is kept apart from the document, so
+// the document can be replaced with the output of a newer node without losing the mappings.
+// Methods, parameters, properties and components are keyed by their name in the document.
+type Overlay struct {
+	Methods    map[string]*MethodOverlay `json:"methods"`
+	Components struct {
+		Schemas map[string]*SchemaOverlay `json:"schemas"`
+	} `json:"components"`
+}
+
+// MethodOverlay holds the Go mappings of a method
+type MethodOverlay struct {
+	GoName      string                   `json:"x-go-name"`
+	GoParams    string                   `json:"x-go-params"`
+	Handwritten bool                     `json:"x-go-handwritten"`
+	Params      map[string]*ParamOverlay `json:"params"`
+	Result      *ParamOverlay            `json:"result"`
+}
+
+// ParamOverlay holds the Go mappings of a parameter or result
+type ParamOverlay struct {
+	AllowBurn bool           `json:"x-go-allow-burn"`
+	Schema    *SchemaOverlay `json:"schema"`
+}
+
+// SchemaOverlay holds the Go mappings of a schema
+type SchemaOverlay struct {
+	GoType               string                    `json:"x-go-type"`
+	Minimum              *int64                    `json:"minimum"`
+	Items                *SchemaOverlay            `json:"items"`
+	Properties           map[string]*SchemaOverlay `json:"properties"`
+	AdditionalProperties *SchemaOverlay            `json:"additionalProperties"`
+}
+
+// parseDocument decodes the OpenRPC document and applies the overlay to it. Mappings of names
+// the document does not contain are an error, so the overlay cannot silently go stale.
+func parseDocument(schema, overlay []byte) (*Document, error) {
+	var doc Document
+	if err := json.Unmarshal(schema, &doc); err != nil {
+		return nil, err
+	}
+	if overlay == nil {
+		return &doc, nil
+	}
+
+	var o Overlay
+	if err := json.Unmarshal(overlay, &o); err != nil {
+		return nil, fmt.Errorf("overlay: %w", err)
+	}
+
+	methods := make(map[string]*Method, len(doc.Methods))
+	for _, m := range doc.Methods {
+		methods[m.Name] = m
+	}
+	for name, mo := range o.Methods {
+		m, ok := methods[name]
+		if !ok {
+			return nil, fmt.Errorf("overlay: method %s is not in the document", name)
+		}
+		if err := mo.apply(m); err != nil {
+			return nil, fmt.Errorf("overlay: method %s: %w", name, err)
+		}
+	}
+
+	for name, so := range o.Components.Schemas {
+		s, ok := doc.Components.Schemas[name]
+		if !ok {
+			return nil, fmt.Errorf("overlay: component %s is not in the document", name)
+		}
+		if err := so.apply(s); err != nil {
+			return nil, fmt.Errorf("overlay: component %s: %w", name, err)
+		}
+	}
+
+	return &doc, nil
+}
+
+func (o *MethodOverlay) apply(m *Method) error {
+	m.GoName = o.GoName
+	m.GoParams = o.GoParams
+	m.Handwritten = o.Handwritten
+
+	for name, po := range o.Params {
+		var param *Param
+		for _, p := range m.Params {
+			if p.Name == name {
+				param = p
+			}
+		}
+		if param == nil {
+			return fmt.Errorf("param %s is not in the document", name)
+		}
+		if err := po.apply(param); err != nil {
+			return fmt.Errorf("param %s: %w", name, err)
+		}
+	}
+
+	if o.Result != nil {
+		if m.Result == nil {
+			return fmt.Errorf("result is not in the document")
+		}
+		if err := o.Result.apply(m.Result); err != nil {
+			return fmt.Errorf("result: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (o *ParamOverlay) apply(p *Param) error {
+	p.AllowBurn = o.AllowBurn
+	if o.Schema == nil {
+		return nil
+	}
+	if p.Schema == nil {
+		return fmt.Errorf("schema is not in the document")
+	}
+	return o.Schema.apply(p.Schema)
+}
+
+func (o *SchemaOverlay) apply(s *Schema) error {
+	s.GoType = o.GoType
+	if o.Minimum != nil {
+		s.Minimum = o.Minimum
+	}
+
+	if o.Items != nil {
+		if s.Items == nil {
+			return fmt.Errorf("items are not in the document")
+		}
+		if err := o.Items.apply(s.Items); err != nil {
+			return fmt.Errorf("items: %w", err)
+		}
+	}
+
+	if o.AdditionalProperties != nil {
+		if s.AdditionalProperties == nil {
+			return fmt.Errorf("additional properties are not in the document")
+		}
+		if err := o.AdditionalProperties.apply(s.AdditionalProperties); err != nil {
+			return fmt.Errorf("additional properties: %w", err)
+		}
+	}
+
+	for name, po := range o.Properties {
+		var schema *Schema
+		for _, p := range s.Properties {
+			if p.Name == name {
+				schema = p.Schema
+			}
+		}
+		if schema == nil {
+			return fmt.Errorf("property %s is not in the document", name)
+		}
+		if err := po.apply(schema); err != nil {
+			return fmt.Errorf("property %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
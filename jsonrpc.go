@@ -74,3 +74,13 @@ func (e *JsonRPCError) Error() string {
 	}
 	return fmt.Sprintf("JSON-RPC Error %d - %s", e.Code, e.Message)
 }
+
+// findResponse returns the response matching the given request id from a batch of responses
+func findResponse(responses []*JsonRPCResponse, id any) (*JsonRPCResponse, error) {
+	for _, resp := range responses {
+		if resp != nil && fmt.Sprint(resp.Id) == fmt.Sprint(id) {
+			return resp, nil
+		}
+	}
+	return nil, fmt.Errorf("no response found for request id %v", id)
+}
@@ -0,0 +1,113 @@
+package keys
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidPath is returned when a derivation path cannot be parsed or derived
+var ErrInvalidPath = errors.New("invalid derivation path")
+
+// NimiqDerivationPath is the path of the first address of a Nimiq Keyguard wallet
+const NimiqDerivationPath = "m/44'/242'/0'/0'"
+
+// hardenedOffset is added to the index of hardened children
+const hardenedOffset uint32 = 1 << 31
+
+// NimiqPath returns the derivation path of the address at index of a Nimiq Keyguard wallet
+func NimiqPath(index uint32) string {
+	return fmt.Sprintf("m/44'/242'/0'/%d'", index)
+}
+
+// ExtendedKey is a private key with the chain code to derive children from it, following SLIP-0010 for Ed25519
+type ExtendedKey struct {
+	PrivateKey PrivateKey
+	ChainCode  [32]byte
+}
+
+// NewMasterKey returns the master key of a seed, e.g. the result of MnemonicToSeed
+func NewMasterKey(seed []byte) *ExtendedKey {
+	return newExtendedKey([]byte("ed25519 seed"), seed)
+}
+
+func newExtendedKey(key, data []byte) *ExtendedKey {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+
+	k := &ExtendedKey{}
+	copy(k.PrivateKey[:], sum[:32])
+	copy(k.ChainCode[:], sum[32:])
+	return k
+}
+
+// Child derives the hardened child at index. Ed25519 only supports hardened derivation,
+// so the index is hardened if it is not already.
+func (k *ExtendedKey) Child(index uint32) *ExtendedKey {
+	data := make([]byte, 37)
+	copy(data[1:], k.PrivateKey[:])
+	binary.BigEndian.PutUint32(data[33:], index|hardenedOffset)
+	return newExtendedKey(k.ChainCode[:], data)
+}
+
+// Derive derives the key at a path like "m/44'/242'/0'/0'", relative to this key
+func (k *ExtendedKey) Derive(path string) (*ExtendedKey, error) {
+	indices, err := ParseDerivationPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	derived := k
+	for _, index := range indices {
+		derived = derived.Child(index)
+	}
+	return derived, nil
+}
+
+// KeyPair returns the key pair of the extended key
+func (k *ExtendedKey) KeyPair() *KeyPair {
+	return FromPrivateKey(k.PrivateKey)
+}
+
+// ParseDerivationPath parses a path like "m/44'/242'/0'/0'" into hardened child indices.
+// Every segment must be hardened, marked by ' or h.
+func ParseDerivationPath(path string) ([]uint32, error) {
+	segments := strings.Split(strings.TrimSpace(path), "/")
+	if segments[0] != "m" {
+		return nil, fmt.Errorf("%w: %q does not start with m", ErrInvalidPath, path)
+	}
+
+	indices := make([]uint32, 0, len(segments)-1)
+	for _, segment := range segments[1:] {
+		trimmed := strings.TrimRight(segment, "'hH")
+		if len(segment)-len(trimmed) != 1 {
+			return nil, fmt.Errorf("%w: segment %q of %q is not hardened", ErrInvalidPath, segment, path)
+		}
+
+		index, err := strconv.ParseUint(trimmed, 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("%w: segment %q of %q: %s", ErrInvalidPath, segment, path, err)
+		}
+		indices = append(indices, uint32(index)|hardenedOffset)
+	}
+	return indices, nil
+}
+
+// FromMnemonic derives the key pair at path from a mnemonic of the english word list, as the
+// Nimiq Keyguard does with NimiqPath. The passphrase is empty for Keyguard wallets.
+func FromMnemonic(mnemonic, passphrase, path string) (*KeyPair, error) {
+	if err := EnglishWordlist.Validate(mnemonic); err != nil {
+		return nil, err
+	}
+
+	key, err := NewMasterKey(MnemonicToSeed(mnemonic, passphrase)).Derive(path)
+	if err != nil {
+		return nil, err
+	}
+	return key.KeyPair(), nil
+}
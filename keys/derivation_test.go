@@ -0,0 +1,70 @@
+package keys
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Test vector 1 for Ed25519 of SLIP-0010
+func TestSLIP10Vector(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	master := NewMasterKey(seed)
+	assert.Equal(t, "90046a93de5380a72b5e45010748567d5ea02bbf6522f979e05c0d8d8ca9fffb", hex.EncodeToString(master.ChainCode[:]))
+	assert.Equal(t, "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7", master.PrivateKey.String())
+
+	child, err := master.Derive("m/0'")
+	assert.NoError(t, err)
+	assert.Equal(t, "8b59aa11380b624e81507a27fedda59fea6d0b779a778918a2fd3590e16e9c69", hex.EncodeToString(child.ChainCode[:]))
+	assert.Equal(t, "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3", child.PrivateKey.String())
+	assert.Equal(t, "8c8a13df77a28f3445213a0f432fde644acaa215fc72dcdf300d5efaa85d350c", child.KeyPair().PublicKey.String())
+
+	child, err = master.Derive("m/0h/1H")
+	assert.NoError(t, err)
+	assert.Equal(t, "a320425f77d1b5c2505a6b1b27382b37368ee640e3557c315416801243552f14", hex.EncodeToString(child.ChainCode[:]))
+	assert.Equal(t, "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2", child.PrivateKey.String())
+
+	assert.Equal(t, child, master.Child(0).Child(1), "Indices are hardened implicitly")
+}
+
+// The keys and addresses along the Keyguard path were computed independently in Python, with
+// hashlib PBKDF2 for the seed, HMAC-SHA512 for SLIP-0010 and the RFC 8032 reference code.
+func TestFromMnemonic(t *testing.T) {
+	mnemonic := strings.Repeat("abandon ", 23) + "art"
+
+	pair, err := FromMnemonic(mnemonic, "", NimiqDerivationPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "e56957e4e5dfcc4e1eb41a0f1c2ace51fa04ea244f3f3e63f4921b87fab10714", pair.PrivateKey.String())
+	assert.Equal(t, "NQ82 FFX8 BC3Q 1VMC 1D2L MK3Q T1UE 2EPV KGNN", pair.Address().String())
+
+	pair, err = FromMnemonic(mnemonic, "", NimiqPath(1))
+	assert.NoError(t, err)
+	assert.Equal(t, "NQ58 FJL5 KU12 TK1T B1T3 455A N2SE 6B3N QKLS", pair.Address().String())
+
+	// The seed of the first BIP39 vector with passphrase "TREZOR" is checked in the mnemonic tests
+	pair, err = FromMnemonic(strings.Repeat("abandon ", 11)+"about", "TREZOR", NimiqDerivationPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "e598453f7a89a3ff6a5facf4dea2304f32403d58b16d8a15b46e9b4fefe2971a", pair.PrivateKey.String())
+	assert.Equal(t, "NQ39 FKMS UR79 KBV7 TA5Q N0AL LMVP F0NY 8S5A", pair.Address().String())
+
+	_, err = FromMnemonic(strings.Repeat("abandon ", 24), "", NimiqDerivationPath)
+	assert.True(t, errors.Is(err, ErrInvalidMnemonic))
+}
+
+func TestParseDerivationPath(t *testing.T) {
+	indices, err := ParseDerivationPath(NimiqDerivationPath)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{44 | hardenedOffset, 242 | hardenedOffset, hardenedOffset, hardenedOffset}, indices)
+
+	indices, err = ParseDerivationPath("m")
+	assert.NoError(t, err)
+	assert.Empty(t, indices)
+
+	for _, invalid := range []string{"", "44'/242'", "m/44", "m/44''", "m/x'", "m/2147483648'"} {
+		_, err := ParseDerivationPath(invalid)
+		assert.True(t, errors.Is(err, ErrInvalidPath), "Expected %q to be invalid", invalid)
+	}
+}
@@ -0,0 +1,143 @@
+// Package keys generates Ed25519 key pairs and derives Nimiq addresses offline,
+// so private keys never have to be sent over the RPC interface.
+package keys
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/redmaner/albatross-go"
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	// PrivateKeyLength is the length of a private key in bytes, which is the Ed25519 seed
+	PrivateKeyLength = ed25519.SeedSize
+	// PublicKeyLength is the length of a public key in bytes
+	PublicKeyLength = ed25519.PublicKeySize
+	// SignatureLength is the length of a signature in bytes
+	SignatureLength = ed25519.SignatureSize
+)
+
+// ErrInvalidKey is returned when a key cannot be parsed
+var ErrInvalidKey = errors.New("invalid key")
+
+// PrivateKey is the 32 byte seed of an Ed25519 key, as used by Nimiq
+type PrivateKey [PrivateKeyLength]byte
+
+// PublicKey is a 32 byte Ed25519 public key
+type PublicKey [PublicKeyLength]byte
+
+// KeyPair holds a private key and the public key derived from it
+type KeyPair struct {
+	PrivateKey PrivateKey
+	PublicKey  PublicKey
+}
+
+// Generate returns a new key pair using the random source of the operating system
+func Generate() (*KeyPair, error) {
+	return GenerateFrom(rand.Reader)
+}
+
+// GenerateFrom returns a new key pair reading the private key from r
+func GenerateFrom(r io.Reader) (*KeyPair, error) {
+	var private PrivateKey
+	if _, err := io.ReadFull(r, private[:]); err != nil {
+		return nil, fmt.Errorf("generating private key: %w", err)
+	}
+	return FromPrivateKey(private), nil
+}
+
+// FromPrivateKey returns the key pair of the given private key
+func FromPrivateKey(private PrivateKey) *KeyPair {
+	return &KeyPair{
+		PrivateKey: private,
+		PublicKey:  private.PublicKey(),
+	}
+}
+
+// ParsePrivateKey parses a hex encoded private key, as returned by the RPC server
+func ParsePrivateKey(s string) (PrivateKey, error) {
+	var private PrivateKey
+	err := decodeHex(s, private[:])
+	return private, err
+}
+
+// ParsePublicKey parses a hex encoded public key
+func ParsePublicKey(s string) (PublicKey, error) {
+	var public PublicKey
+	err := decodeHex(s, public[:])
+	return public, err
+}
+
+func decodeHex(s string, dst []byte) error {
+	b, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(s), "0x"))
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidKey, err)
+	}
+	if len(b) != len(dst) {
+		return fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidKey, len(dst), len(b))
+	}
+	copy(dst, b)
+	return nil
+}
+
+// PublicKey derives the public key of the private key
+func (k PrivateKey) PublicKey() PublicKey {
+	var public PublicKey
+	copy(public[:], k.ed25519().Public().(ed25519.PublicKey))
+	return public
+}
+
+// String returns the hex encoded private key
+func (k PrivateKey) String() string {
+	return hex.EncodeToString(k[:])
+}
+
+func (k PrivateKey) ed25519() ed25519.PrivateKey {
+	return ed25519.NewKeyFromSeed(k[:])
+}
+
+// Address derives the Nimiq address of the public key, which is the first 20 bytes
+// of its Blake2b hash
+func (k PublicKey) Address() albatross.Address {
+	hash := blake2b.Sum256(k[:])
+
+	var address albatross.Address
+	copy(address[:], hash[:albatross.AddressLength])
+	return address
+}
+
+// Verify returns whether signature is a valid signature of message by this key
+func (k PublicKey) Verify(message, signature []byte) bool {
+	return len(signature) == SignatureLength && ed25519.Verify(k[:], message, signature)
+}
+
+// String returns the hex encoded public key
+func (k PublicKey) String() string {
+	return hex.EncodeToString(k[:])
+}
+
+// Address returns the Nimiq address of the key pair
+func (p *KeyPair) Address() albatross.Address {
+	return p.PublicKey.Address()
+}
+
+// Sign returns the Ed25519 signature of message
+func (p *KeyPair) Sign(message []byte) []byte {
+	return ed25519.Sign(p.PrivateKey.ed25519(), message)
+}
+
+// ReturnAccount returns the key pair in the same shape as the account created by CreateAccount
+func (p *KeyPair) ReturnAccount() *albatross.ReturnAccount {
+	return &albatross.ReturnAccount{
+		Address:    p.Address(),
+		PublicKey:  p.PublicKey.String(),
+		PrivateKey: p.PrivateKey.String(),
+	}
+}
@@ -0,0 +1,72 @@
+package keys
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// The private and public key are the first test vector of RFC 8032. The address was computed
+// independently with Python's hashlib Blake2b and the IBAN checksum of the address format.
+const (
+	testPrivateKey = "9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60"
+	testPublicKey  = "d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a"
+	testAddress    = "NQ17 F14S QC29 D05X 3TTN 5TY0 SDP0 2URU 6HJE"
+)
+
+func TestFromPrivateKey(t *testing.T) {
+	private, err := ParsePrivateKey(testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pair := FromPrivateKey(private)
+	assert.Equal(t, testPublicKey, pair.PublicKey.String())
+	assert.Equal(t, testAddress, pair.Address().String())
+
+	account := pair.ReturnAccount()
+	assert.Equal(t, testAddress, account.Address.String())
+	assert.Equal(t, testPublicKey, account.PublicKey)
+	assert.Equal(t, testPrivateKey, account.PrivateKey)
+}
+
+func TestGenerate(t *testing.T) {
+	pair, err := Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, pair.PrivateKey.PublicKey(), pair.PublicKey)
+
+	other, err := Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotEqual(t, pair.PrivateKey, other.PrivateKey)
+
+	_, err = GenerateFrom(bytes.NewReader([]byte{1, 2, 3}))
+	assert.Error(t, err, "Generating from a short random source should fail")
+}
+
+func TestSignAndVerify(t *testing.T) {
+	private, _ := ParsePrivateKey(testPrivateKey)
+	pair := FromPrivateKey(private)
+
+	signature := pair.Sign([]byte("hello"))
+	assert.Len(t, signature, SignatureLength)
+	assert.True(t, pair.PublicKey.Verify([]byte("hello"), signature))
+	assert.False(t, pair.PublicKey.Verify([]byte("hello!"), signature))
+	assert.False(t, pair.PublicKey.Verify([]byte("hello"), signature[:10]))
+}
+
+func TestParseInvalidKeys(t *testing.T) {
+	for _, invalid := range []string{"", "zz", testPrivateKey[:62], testPrivateKey + "00"} {
+		_, err := ParsePrivateKey(invalid)
+		assert.True(t, errors.Is(err, ErrInvalidKey), "Expected %q to be invalid", invalid)
+	}
+
+	public, err := ParsePublicKey("0x" + testPublicKey)
+	assert.NoError(t, err)
+	assert.Equal(t, testPublicKey, public.String())
+}
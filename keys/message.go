@@ -0,0 +1,65 @@
+package keys
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/redmaner/albatross-go"
+)
+
+// SignedMessagePrefix is prepended to messages before signing, so a signed message can never
+// be a valid transaction
+const SignedMessagePrefix = "\x16Nimiq Signed Message:\n"
+
+// ErrInvalidSignature is returned when a signature does not match the message or the signer
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// HashMessage returns the hash that is signed for a message: the SHA256 hash of the prefix,
+// the length of the message in decimal and the message
+func HashMessage(message []byte) [sha256.Size]byte {
+	data := make([]byte, 0, len(SignedMessagePrefix)+len(message)+8)
+	data = append(data, SignedMessagePrefix...)
+	data = strconv.AppendInt(data, int64(len(message)), 10)
+	data = append(data, message...)
+	return sha256.Sum256(data)
+}
+
+// SignMessage signs a message with the Nimiq message prefix and returns the signature with the
+// public key, in the same shape as the Sign method of the RPC server
+func (p *KeyPair) SignMessage(message []byte) *albatross.ReturnSignature {
+	hash := HashMessage(message)
+	return &albatross.ReturnSignature{
+		PublicKey: albatross.PublicKey(p.PublicKey.String()),
+		Signature: albatross.Signature(hex.EncodeToString(p.Sign(hash[:]))),
+	}
+}
+
+// VerifyMessage returns whether signature is a valid signature of message by this key
+func (k PublicKey) VerifyMessage(message, signature []byte) bool {
+	hash := HashMessage(message)
+	return k.Verify(hash[:], signature)
+}
+
+// VerifySignedMessage verifies that a signature returned by SignMessage or by the RPC server
+// is valid for message and that its public key belongs to signer
+func VerifySignedMessage(message []byte, signature *albatross.ReturnSignature, signer albatross.Address) error {
+	publicKey, err := ParsePublicKey(string(signature.PublicKey))
+	if err != nil {
+		return err
+	}
+	if publicKey.Address() != signer {
+		return fmt.Errorf("%w: public key belongs to %s, not to %s", ErrInvalidSignature, publicKey.Address(), signer)
+	}
+
+	sig, err := hex.DecodeString(string(signature.Signature))
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSignature, err)
+	}
+	if !publicKey.VerifyMessage(message, sig) {
+		return fmt.Errorf("%w: signature does not match the message", ErrInvalidSignature)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package keys
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/redmaner/albatross-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashMessage(t *testing.T) {
+	// SHA256 of "\x16Nimiq Signed Message:\n5hello"
+	hash := HashMessage([]byte("hello"))
+	assert.Equal(t, "fd72a0cd679fd00d472df44647303eadebe81903fe59d5b20e12961b7ea654a1", hex.EncodeToString(hash[:]))
+	assert.NotEqual(t, HashMessage([]byte("hello!")), hash)
+
+	// The length prevents ambiguity between prefix, length and message
+	assert.NotEqual(t, HashMessage([]byte("1")), HashMessage([]byte("")))
+}
+
+func TestSignMessage(t *testing.T) {
+	private, _ := ParsePrivateKey(testPrivateKey)
+	pair := FromPrivateKey(private)
+
+	signature := pair.SignMessage([]byte("Login to example.com"))
+	assert.Equal(t, albatross.PublicKey(testPublicKey), signature.PublicKey)
+	assert.NoError(t, VerifySignedMessage([]byte("Login to example.com"), signature, pair.Address()))
+
+	err := VerifySignedMessage([]byte("Login to example.org"), signature, pair.Address())
+	assert.True(t, errors.Is(err, ErrInvalidSignature))
+
+	other, _ := albatross.ParseAddress("NQ07 0000 0000 0000 0000 0000 0000 0000 0000")
+	err = VerifySignedMessage([]byte("Login to example.com"), signature, other)
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "Signature must belong to the signer")
+
+	raw, _ := hex.DecodeString(string(signature.Signature))
+	assert.True(t, pair.PublicKey.VerifyMessage([]byte("Login to example.com"), raw))
+	assert.False(t, pair.PublicKey.Verify([]byte("Login to example.com"), raw), "Messages are signed with prefix")
+}
+
+func TestVerifyMalformedSignature(t *testing.T) {
+	private, _ := ParsePrivateKey(testPrivateKey)
+	pair := FromPrivateKey(private)
+
+	err := VerifySignedMessage([]byte("hello"), &albatross.ReturnSignature{PublicKey: "zz"}, pair.Address())
+	assert.True(t, errors.Is(err, ErrInvalidKey))
+
+	err = VerifySignedMessage([]byte("hello"), &albatross.ReturnSignature{PublicKey: testPublicKey, Signature: "zz"}, pair.Address())
+	assert.True(t, errors.Is(err, ErrInvalidSignature))
+}
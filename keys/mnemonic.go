@@ -0,0 +1,142 @@
+package keys
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// ErrInvalidMnemonic is returned when a mnemonic has an unknown word, length or checksum
+var ErrInvalidMnemonic = errors.New("invalid mnemonic")
+
+//go:embed wordlists/english.txt
+var englishWords string
+
+// EnglishWordlist is the english BIP39 word list, which is used by the Nimiq Keyguard
+var EnglishWordlist = mustWordlist(strings.Fields(englishWords))
+
+// Wordlist is a list of 2048 words that encodes entropy as a BIP39 mnemonic
+type Wordlist struct {
+	words []string
+	index map[string]int
+}
+
+// NewWordlist returns a word list of 2048 distinct words, e.g. one of the BIP39 word lists
+func NewWordlist(words []string) (*Wordlist, error) {
+	if len(words) != 2048 {
+		return nil, fmt.Errorf("word list must have 2048 words, got %d", len(words))
+	}
+
+	w := &Wordlist{words: words, index: make(map[string]int, len(words))}
+	for i, word := range words {
+		if _, ok := w.index[word]; ok {
+			return nil, fmt.Errorf("word list contains %q twice", word)
+		}
+		w.index[word] = i
+	}
+	return w, nil
+}
+
+func mustWordlist(words []string) *Wordlist {
+	w, err := NewWordlist(words)
+	if err != nil {
+		panic(err)
+	}
+	return w
+}
+
+// Generate returns a new mnemonic of entropyBits random bits, which must be a multiple of 32
+// from 128 to 256. The Nimiq Keyguard uses 256 bits, resulting in 24 words.
+func (w *Wordlist) Generate(entropyBits int) (string, error) {
+	if entropyBits%32 != 0 || entropyBits < 128 || entropyBits > 256 {
+		return "", fmt.Errorf("%w: entropy of %d bits is not supported", ErrInvalidMnemonic, entropyBits)
+	}
+
+	entropy := make([]byte, entropyBits/8)
+	if _, err := io.ReadFull(rand.Reader, entropy); err != nil {
+		return "", fmt.Errorf("generating entropy: %w", err)
+	}
+	return w.FromEntropy(entropy)
+}
+
+// FromEntropy encodes entropy of 16 to 32 bytes as mnemonic
+func (w *Wordlist) FromEntropy(entropy []byte) (string, error) {
+	if len(entropy)%4 != 0 || len(entropy) < 16 || len(entropy) > 32 {
+		return "", fmt.Errorf("%w: entropy of %d bytes is not supported", ErrInvalidMnemonic, len(entropy))
+	}
+
+	// The entropy is followed by the first bits of its SHA256 hash as checksum
+	checksum := sha256.Sum256(entropy)
+	data := append(append([]byte{}, entropy...), checksum[0])
+	wordCount := (len(entropy)*8 + len(entropy)/4) / 11
+
+	words := make([]string, wordCount)
+	for i := range words {
+		words[i] = w.words[readBits(data, i*11, 11)]
+	}
+	return strings.Join(words, " "), nil
+}
+
+// Entropy decodes a mnemonic into its entropy and verifies its checksum
+func (w *Wordlist) Entropy(mnemonic string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	if len(words)%3 != 0 || len(words) < 12 || len(words) > 24 {
+		return nil, fmt.Errorf("%w: %d words", ErrInvalidMnemonic, len(words))
+	}
+
+	checksumBits := len(words) / 3
+	data := make([]byte, (len(words)*11+7)/8)
+	for i, word := range words {
+		index, ok := w.index[word]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown word %q", ErrInvalidMnemonic, word)
+		}
+		writeBits(data, i*11, 11, index)
+	}
+
+	entropy := data[:(len(words)*11-checksumBits)/8]
+	checksum := sha256.Sum256(entropy)
+	if readBits(data, len(entropy)*8, checksumBits) != readBits(checksum[:], 0, checksumBits) {
+		return nil, fmt.Errorf("%w: checksum does not match", ErrInvalidMnemonic)
+	}
+	return entropy, nil
+}
+
+// Validate returns an error if the mnemonic is not valid for the word list
+func (w *Wordlist) Validate(mnemonic string) error {
+	_, err := w.Entropy(mnemonic)
+	return err
+}
+
+// MnemonicToSeed returns the 64 byte BIP39 seed of a mnemonic protected by an optional passphrase.
+// The mnemonic is not validated. Non ASCII mnemonics and passphrases must be NFKD normalized.
+func MnemonicToSeed(mnemonic, passphrase string) []byte {
+	normalized := strings.Join(strings.Fields(mnemonic), " ")
+	return pbkdf2.Key([]byte(normalized), []byte("mnemonic"+passphrase), 2048, 64, sha512.New)
+}
+
+// readBits reads count bits from data starting at bit offset, most significant bit first
+func readBits(data []byte, offset, count int) int {
+	value := 0
+	for i := offset; i < offset+count; i++ {
+		value = value<<1 | int(data[i/8]>>(7-i%8)&1)
+	}
+	return value
+}
+
+// writeBits writes the count least significant bits of value into data starting at bit offset
+func writeBits(data []byte, offset, count, value int) {
+	for i := 0; i < count; i++ {
+		if value>>(count-1-i)&1 == 1 {
+			bit := offset + i
+			data[bit/8] |= 0x80 >> (bit % 8)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package keys
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Test vectors of BIP39, see https://github.com/trezor/python-mnemonic/blob/master/vectors.json
+var bip39Vectors = []struct {
+	entropy  string
+	mnemonic string
+	seed     string
+}{
+	{
+		"00000000000000000000000000000000",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		"c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+	},
+	{
+		"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+		"legal winner thank year wave sausage worth useful legal winner thank yellow",
+		"2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607",
+	},
+	{
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		strings.Repeat("zoo ", 23) + "vote",
+		"",
+	},
+}
+
+func TestMnemonicVectors(t *testing.T) {
+	for _, vector := range bip39Vectors {
+		entropy, _ := hex.DecodeString(vector.entropy)
+
+		mnemonic, err := EnglishWordlist.FromEntropy(entropy)
+		assert.NoError(t, err)
+		assert.Equal(t, vector.mnemonic, mnemonic)
+
+		decoded, err := EnglishWordlist.Entropy(mnemonic)
+		assert.NoError(t, err)
+		assert.Equal(t, entropy, decoded)
+
+		if vector.seed != "" {
+			assert.Equal(t, vector.seed, hex.EncodeToString(MnemonicToSeed(mnemonic, "TREZOR")))
+		}
+	}
+}
+
+func TestGenerateMnemonic(t *testing.T) {
+	mnemonic, err := EnglishWordlist.Generate(256)
+	assert.NoError(t, err)
+	assert.Len(t, strings.Fields(mnemonic), 24)
+	assert.NoError(t, EnglishWordlist.Validate(mnemonic))
+
+	mnemonic, err = EnglishWordlist.Generate(128)
+	assert.NoError(t, err)
+	assert.Len(t, strings.Fields(mnemonic), 12)
+
+	_, err = EnglishWordlist.Generate(100)
+	assert.True(t, errors.Is(err, ErrInvalidMnemonic))
+}
+
+func TestValidateMnemonic(t *testing.T) {
+	invalid := []string{
+		"",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon nimiq",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+	}
+	for _, mnemonic := range invalid {
+		err := EnglishWordlist.Validate(mnemonic)
+		assert.True(t, errors.Is(err, ErrInvalidMnemonic), "Expected %q to be invalid", mnemonic)
+	}
+}
+
+func TestNewWordlist(t *testing.T) {
+	_, err := NewWordlist([]string{"a", "b"})
+	assert.Error(t, err)
+
+	words := strings.Fields(englishWords)
+	words[1] = words[0]
+	_, err = NewWordlist(words)
+	assert.Error(t, err)
+
+	assert.True(t, bytes.HasPrefix([]byte(englishWords), []byte("abandon\nability\n")))
+}
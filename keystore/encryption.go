@@ -0,0 +1,171 @@
+// Package keystore encrypts private keys with a passphrase in the Nimiq encrypted key format
+// and keeps them in a local directory, so raw private keys never have to be stored or sent.
+package keystore
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+
+	"github.com/redmaner/albatross-go/internal/argon2d"
+	"github.com/redmaner/albatross-go/keys"
+	"golang.org/x/crypto/blake2b"
+)
+
+// Version is the version of the encrypted key format written by Encrypt
+const Version = 3
+
+// versionWithoutPurpose is the version of the format exported by older Nimiq wallets,
+// which only contains the checksum and the private key
+const versionWithoutPurpose = 2
+
+// DefaultRounds is the number of Argon2d passes used by the Nimiq Keyguard
+const DefaultRounds = 256
+
+// maxRoundsLog is the highest log2 of rounds that is accepted. Nimiq wallets write
+// DefaultRounds, higher values would only let a crafted key burn CPU before the passphrase
+// is checked.
+const maxRoundsLog = 8
+
+const (
+	saltLength     = 16
+	checksumLength = 4
+	secretLength   = 32
+	headerLength   = 2 + saltLength
+
+	// kdfMemory is the memory of the key derivation in KiB
+	kdfMemory = 512
+)
+
+var (
+	// ErrWrongPassphrase is returned when an encrypted key cannot be decrypted with the passphrase
+	ErrWrongPassphrase = errors.New("wrong passphrase")
+	// ErrUnsupportedVersion is returned for encrypted keys of other versions than 2 and 3
+	ErrUnsupportedVersion = errors.New("unsupported encrypted key version")
+	// ErrInvalidEncryptedKey is returned when an encrypted key is malformed
+	ErrInvalidEncryptedKey = errors.New("invalid encrypted key")
+)
+
+// Purpose identifies what kind of secret is encrypted
+type Purpose uint32
+
+const (
+	PurposePrivateKey Purpose = 0x42000001 // A single private key
+	PurposeEntropy    Purpose = 0x42000002 // The entropy of a BIP39 mnemonic
+)
+
+// Secret is a decrypted secret with its purpose
+type Secret struct {
+	Purpose Purpose
+	Data    [secretLength]byte
+}
+
+// PrivateKeySecret returns the secret of a private key
+func PrivateKeySecret(key keys.PrivateKey) *Secret {
+	return &Secret{Purpose: PurposePrivateKey, Data: key}
+}
+
+// KeyPair returns the key pair of the secret. For entropy it is the first address of the
+// Keyguard wallet derived from the mnemonic of the entropy.
+func (s *Secret) KeyPair() (*keys.KeyPair, error) {
+	switch s.Purpose {
+	case PurposePrivateKey:
+		return keys.FromPrivateKey(s.Data), nil
+	case PurposeEntropy:
+		mnemonic, err := keys.EnglishWordlist.FromEntropy(s.Data[:])
+		if err != nil {
+			return nil, err
+		}
+		return keys.FromMnemonic(mnemonic, "", keys.NimiqDerivationPath)
+	}
+	return nil, fmt.Errorf("unknown purpose %#x", uint32(s.Purpose))
+}
+
+// Encrypt encrypts a secret with the passphrase using DefaultRounds
+func Encrypt(secret *Secret, passphrase []byte) ([]byte, error) {
+	return EncryptWithRounds(secret, passphrase, DefaultRounds)
+}
+
+// EncryptWithRounds encrypts a secret with the passphrase. Rounds must be a power of two of
+// at most DefaultRounds.
+// The result is laid out as: version | log2 of rounds | salt | ciphertext, where the
+// ciphertext is the Argon2d key of the passphrase XORed with checksum | purpose | secret.
+func EncryptWithRounds(secret *Secret, passphrase []byte, rounds uint32) ([]byte, error) {
+	if rounds == 0 || rounds&(rounds-1) != 0 || rounds > 1<<maxRoundsLog {
+		return nil, fmt.Errorf("rounds must be a power of two of at most %d, got %d", 1<<maxRoundsLog, rounds)
+	}
+
+	salt := make([]byte, saltLength)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, fmt.Errorf("generating salt: %w", err)
+	}
+	return encrypt(secret, passphrase, salt, rounds), nil
+}
+
+func encrypt(secret *Secret, passphrase, salt []byte, rounds uint32) []byte {
+	plaintext := make([]byte, checksumLength+4+secretLength)
+	binary.BigEndian.PutUint32(plaintext[checksumLength:], uint32(secret.Purpose))
+	copy(plaintext[checksumLength+4:], secret.Data[:])
+	checksum := blake2b.Sum256(plaintext[checksumLength:])
+	copy(plaintext, checksum[:checksumLength])
+
+	encrypted := make([]byte, 0, headerLength+len(plaintext))
+	encrypted = append(encrypted, Version, byte(bits.TrailingZeros32(rounds)))
+	encrypted = append(encrypted, salt...)
+	return append(encrypted, otpKdf(plaintext, passphrase, salt, rounds)...)
+}
+
+// Decrypt decrypts an encrypted key with the passphrase. Version 2 keys without purpose, as
+// exported by older Nimiq wallets, are decrypted as private keys.
+func Decrypt(encrypted, passphrase []byte) (*Secret, error) {
+	if len(encrypted) == 0 {
+		return nil, ErrInvalidEncryptedKey
+	}
+
+	var length int
+	switch encrypted[0] {
+	case versionWithoutPurpose:
+		length = headerLength + checksumLength + secretLength
+	case Version:
+		length = headerLength + checksumLength + 4 + secretLength
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, encrypted[0])
+	}
+	if len(encrypted) != length {
+		return nil, fmt.Errorf("%w: unexpected length of %d bytes for version %d", ErrInvalidEncryptedKey, len(encrypted), encrypted[0])
+	}
+	if encrypted[1] > maxRoundsLog {
+		return nil, fmt.Errorf("%w: 2^%d rounds exceed the maximum of %d", ErrInvalidEncryptedKey, encrypted[1], 1<<maxRoundsLog)
+	}
+
+	rounds := uint32(1) << encrypted[1]
+	plaintext := otpKdf(encrypted[headerLength:], passphrase, encrypted[2:headerLength], rounds)
+
+	checksum := blake2b.Sum256(plaintext[checksumLength:])
+	if subtle.ConstantTimeCompare(checksum[:checksumLength], plaintext[:checksumLength]) != 1 {
+		return nil, ErrWrongPassphrase
+	}
+
+	secret := &Secret{Purpose: PurposePrivateKey}
+	payload := plaintext[checksumLength:]
+	if encrypted[0] == Version {
+		secret.Purpose = Purpose(binary.BigEndian.Uint32(payload))
+		payload = payload[4:]
+	}
+	copy(secret.Data[:], payload)
+	return secret, nil
+}
+
+// otpKdf XORs data with the Argon2d key of the passphrase, which encrypts and decrypts alike
+func otpKdf(data, passphrase, salt []byte, rounds uint32) []byte {
+	key := argon2d.Key(passphrase, salt, rounds, kdfMemory, 1, uint32(len(data)))
+
+	for i := range key {
+		key[i] ^= data[i]
+	}
+	return key
+}
@@ -0,0 +1,120 @@
+package keystore
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/redmaner/albatross-go/keys"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/blake2b"
+)
+
+const testPrivateKey = "9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60"
+
+// Encrypted with passphrase "password", 256 rounds and salt 00..0f. This vector was produced by
+// this package, not exported from Keyguard or @nimiq/core, so compatibility with keys of Nimiq
+// wallets is not proven by it. Only the Argon2d key derivation is verified, against RFC 9106.
+const testEncryptedKey = "0308000102030405060708090a0b0c0d0e0f" +
+	"0b0012faeecdbbfd0b6f42d6d26b41e2293a520688031ce6cbca754ff977a5bc81df86aef10808fa"
+
+func testKey(t *testing.T) keys.PrivateKey {
+	t.Helper()
+	key, err := keys.ParsePrivateKey(testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestDecryptVector(t *testing.T) {
+	encrypted, _ := hex.DecodeString(testEncryptedKey)
+
+	secret, err := Decrypt(encrypted, []byte("password"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, PurposePrivateKey, secret.Purpose)
+	assert.Equal(t, testPrivateKey, hex.EncodeToString(secret.Data[:]))
+
+	_, err = Decrypt(encrypted, []byte("wrong"))
+	assert.True(t, errors.Is(err, ErrWrongPassphrase))
+}
+
+func TestEncryptRoundTrip(t *testing.T) {
+	secret := PrivateKeySecret(testKey(t))
+
+	encrypted, err := EncryptWithRounds(secret, []byte("passphrase"), 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, encrypted, 58)
+	assert.Equal(t, byte(Version), encrypted[0])
+	assert.Equal(t, byte(2), encrypted[1], "Rounds are stored as power of two")
+
+	decrypted, err := Decrypt(encrypted, []byte("passphrase"))
+	assert.NoError(t, err)
+	assert.Equal(t, secret, decrypted)
+
+	again, _ := EncryptWithRounds(secret, []byte("passphrase"), 4)
+	assert.NotEqual(t, encrypted, again, "Every encryption should use a new salt")
+
+	_, err = EncryptWithRounds(secret, []byte("passphrase"), 3)
+	assert.Error(t, err)
+
+	_, err = EncryptWithRounds(secret, []byte("passphrase"), 512)
+	assert.Error(t, err)
+}
+
+func TestDecryptVersion2(t *testing.T) {
+	// Version 2 exports contain only checksum and private key, without purpose
+	key := testKey(t)
+	checksum := blake2b.Sum256(key[:])
+	plaintext := append(checksum[:checksumLength], key[:]...)
+
+	salt := make([]byte, saltLength)
+	encrypted := append([]byte{versionWithoutPurpose, 0}, salt...)
+	encrypted = append(encrypted, otpKdf(plaintext, []byte("pass"), salt, 1)...)
+
+	secret, err := Decrypt(encrypted, []byte("pass"))
+	assert.NoError(t, err)
+	assert.Equal(t, PrivateKeySecret(key), secret)
+
+	// The length must match the version
+	encrypted[0] = Version
+	_, err = Decrypt(encrypted, []byte("pass"))
+	assert.True(t, errors.Is(err, ErrInvalidEncryptedKey))
+
+	_, err = Decrypt(append([]byte{1}, encrypted[1:]...), []byte("pass"))
+	assert.True(t, errors.Is(err, ErrUnsupportedVersion))
+}
+
+func TestDecryptInvalid(t *testing.T) {
+	encrypted, _ := hex.DecodeString(testEncryptedKey)
+
+	_, err := Decrypt(nil, nil)
+	assert.True(t, errors.Is(err, ErrInvalidEncryptedKey))
+
+	_, err = Decrypt(append([]byte{4}, encrypted[1:]...), []byte("password"))
+	assert.True(t, errors.Is(err, ErrUnsupportedVersion))
+
+	_, err = Decrypt(encrypted[:40], []byte("password"))
+	assert.True(t, errors.Is(err, ErrInvalidEncryptedKey))
+
+	// Rounds beyond what Nimiq wallets write are rejected before any key derivation
+	tooManyRounds := append([]byte{}, encrypted...)
+	tooManyRounds[1] = 31
+	_, err = Decrypt(tooManyRounds, []byte("password"))
+	assert.True(t, errors.Is(err, ErrInvalidEncryptedKey))
+}
+
+func TestEntropySecret(t *testing.T) {
+	secret := &Secret{Purpose: PurposeEntropy}
+
+	pair, err := secret.KeyPair()
+	assert.NoError(t, err)
+	assert.Equal(t, "NQ82 FFX8 BC3Q 1VMC 1D2L MK3Q T1UE 2EPV KGNN", pair.Address().String())
+
+	_, err = (&Secret{Purpose: 1}).KeyPair()
+	assert.Error(t, err)
+}
@@ -0,0 +1,146 @@
+package keystore
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/redmaner/albatross-go"
+	"github.com/redmaner/albatross-go/keys"
+)
+
+// ErrKeyNotFound is returned when the store has no key for an address
+var ErrKeyNotFound = errors.New("key not found")
+
+const keyFileExtension = ".key"
+
+// RawKeyImporter imports raw private keys on a node, it is implemented by HttpClient
+type RawKeyImporter interface {
+	ImportAccountByRawKey(rawKey string, passphrase ...string) error
+}
+
+var _ RawKeyImporter = (*albatross.HttpClient)(nil)
+
+// Store keeps encrypted keys in a directory, one hex encoded file per address
+type Store struct {
+	dir string
+}
+
+// NewStore returns a store in dir, which is created if it does not exist
+func NewStore(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	return &Store{dir: dir}, nil
+}
+
+func (s *Store) path(address albatross.Address) string {
+	return filepath.Join(s.dir, address.Hex()+keyFileExtension)
+}
+
+// Add encrypts the private key of the key pair with the passphrase and stores it
+func (s *Store) Add(pair *keys.KeyPair, passphrase []byte) error {
+	encrypted, err := Encrypt(PrivateKeySecret(pair.PrivateKey), passphrase)
+	if err != nil {
+		return err
+	}
+	return s.write(pair.Address(), encrypted)
+}
+
+// Import stores an encrypted key exported by a Nimiq wallet or by Export. It is decrypted
+// once to verify the passphrase and to determine its address.
+func (s *Store) Import(encrypted, passphrase []byte) (albatross.Address, error) {
+	secret, err := Decrypt(encrypted, passphrase)
+	if err != nil {
+		return albatross.Address{}, err
+	}
+	pair, err := secret.KeyPair()
+	if err != nil {
+		return albatross.Address{}, err
+	}
+
+	return pair.Address(), s.write(pair.Address(), encrypted)
+}
+
+// Export returns the encrypted key of an address
+func (s *Store) Export(address albatross.Address) ([]byte, error) {
+	data, err := os.ReadFile(s.path(address))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, address)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, err := hex.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidEncryptedKey, err)
+	}
+	return encrypted, nil
+}
+
+// Unlock decrypts the key of an address, e.g. to sign transactions offline
+func (s *Store) Unlock(address albatross.Address, passphrase []byte) (*keys.KeyPair, error) {
+	encrypted, err := s.Export(address)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := Decrypt(encrypted, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return secret.KeyPair()
+}
+
+// List returns the addresses of all keys in the store
+func (s *Store) List() ([]albatross.Address, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := []albatross.Address{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, keyFileExtension) {
+			continue
+		}
+
+		address, err := albatross.AddressFromHex(strings.TrimSuffix(name, keyFileExtension))
+		if err != nil {
+			continue
+		}
+		addresses = append(addresses, address)
+	}
+
+	sort.Slice(addresses, func(i, j int) bool { return addresses[i].Hex() < addresses[j].Hex() })
+	return addresses, nil
+}
+
+// Remove deletes the key of an address from the store
+func (s *Store) Remove(address albatross.Address) error {
+	err := os.Remove(s.path(address))
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%w: %s", ErrKeyNotFound, address)
+	}
+	return err
+}
+
+// ImportToNode unlocks the key of an address and imports it on the node with ImportAccountByRawKey.
+// The node encrypts the key with nodePassphrase if given.
+func (s *Store) ImportToNode(client RawKeyImporter, address albatross.Address, passphrase []byte, nodePassphrase ...string) error {
+	pair, err := s.Unlock(address, passphrase)
+	if err != nil {
+		return err
+	}
+	return client.ImportAccountByRawKey(pair.PrivateKey.String(), nodePassphrase...)
+}
+
+func (s *Store) write(address albatross.Address, encrypted []byte) error {
+	return os.WriteFile(s.path(address), []byte(hex.EncodeToString(encrypted)), 0o600)
+}
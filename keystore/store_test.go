@@ -0,0 +1,74 @@
+package keystore
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/redmaner/albatross-go"
+	"github.com/redmaner/albatross-go/keys"
+	"github.com/stretchr/testify/assert"
+)
+
+type testImporter struct {
+	rawKey     string
+	passphrase []string
+}
+
+func (i *testImporter) ImportAccountByRawKey(rawKey string, passphrase ...string) error {
+	i.rawKey = rawKey
+	i.passphrase = passphrase
+	return nil
+}
+
+func TestStore(t *testing.T) {
+	store, err := NewStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pair := keys.FromPrivateKey(testKey(t))
+	assert.NoError(t, store.Add(pair, []byte("secret")))
+
+	addresses, err := store.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []albatross.Address{pair.Address()}, addresses)
+
+	unlocked, err := store.Unlock(pair.Address(), []byte("secret"))
+	assert.NoError(t, err)
+	assert.Equal(t, pair, unlocked)
+
+	_, err = store.Unlock(pair.Address(), []byte("wrong"))
+	assert.True(t, errors.Is(err, ErrWrongPassphrase))
+
+	importer := &testImporter{}
+	assert.NoError(t, store.ImportToNode(importer, pair.Address(), []byte("secret"), "node"))
+	assert.Equal(t, testPrivateKey, importer.rawKey)
+	assert.Equal(t, []string{"node"}, importer.passphrase)
+
+	assert.NoError(t, store.Remove(pair.Address()))
+	_, err = store.Unlock(pair.Address(), []byte("secret"))
+	assert.True(t, errors.Is(err, ErrKeyNotFound))
+	assert.True(t, errors.Is(store.Remove(pair.Address()), ErrKeyNotFound))
+}
+
+func TestStoreImportExport(t *testing.T) {
+	source, _ := NewStore(t.TempDir())
+	target, _ := NewStore(t.TempDir())
+
+	pair := keys.FromPrivateKey(testKey(t))
+	assert.NoError(t, source.Add(pair, []byte("secret")))
+
+	exported, err := source.Export(pair.Address())
+	assert.NoError(t, err)
+
+	_, err = target.Import(exported, []byte("wrong"))
+	assert.True(t, errors.Is(err, ErrWrongPassphrase), "Keys with a wrong passphrase should not be imported")
+
+	address, err := target.Import(exported, []byte("secret"))
+	assert.NoError(t, err)
+	assert.Equal(t, pair.Address(), address)
+
+	reexported, err := target.Export(address)
+	assert.NoError(t, err)
+	assert.Equal(t, exported, reexported, "Imported keys are stored unchanged")
+}
@@ -0,0 +1,205 @@
+package albatross
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// Luna is the smallest unit of NIM and 100’000 (1e5) Luna equals 1 NIM
+type Luna uint64
+
+// MaxLuna is the total supply of the Nimiq network, 21 billion NIM or 21e14 Luna
+const MaxLuna Luna = 2100000000000000
+
+// LunaDecimals is the number of decimals of NIM that can be represented in Luna
+const LunaDecimals = 5
+
+const nimInLuna int64 = 100000
+
+var (
+	// ErrLunaOverflow is returned when an operation on Luna overflows uint64
+	ErrLunaOverflow = errors.New("luna overflow")
+	// ErrLunaUnderflow is returned when a subtraction of Luna would become negative
+	ErrLunaUnderflow = errors.New("luna underflow")
+	// ErrExceedsSupply is returned when an amount of Luna exceeds the total supply
+	ErrExceedsSupply = errors.New("amount exceeds total supply")
+	// ErrDivisionByZero is returned when Luna is divided by zero
+	ErrDivisionByZero = errors.New("division by zero")
+	// ErrInvalidAmount is returned when an amount of NIM cannot be parsed
+	ErrInvalidAmount = errors.New("invalid amount")
+)
+
+// RoundingMode determines how fractional Luna are rounded by Div and MulDiv
+type RoundingMode int
+
+const (
+	RoundDown     RoundingMode = iota // Round towards zero
+	RoundUp                           // Round away from zero, e.g. to never underpay a fee
+	RoundHalfUp                       // Round to nearest, ties away from zero
+	RoundHalfEven                     // Round to nearest, ties to even
+)
+
+// Add returns l + o, or an error if the sum exceeds the total supply
+func (l Luna) Add(o Luna) (Luna, error) {
+	sum, carry := bits.Add64(uint64(l), uint64(o), 0)
+	if carry != 0 {
+		return 0, ErrLunaOverflow
+	}
+	return checkSupply(Luna(sum))
+}
+
+// Sub returns l - o, or an error if o is greater than l
+func (l Luna) Sub(o Luna) (Luna, error) {
+	if o > l {
+		return 0, fmt.Errorf("%w: %d - %d", ErrLunaUnderflow, l, o)
+	}
+	return l - o, nil
+}
+
+// Mul returns l * n, or an error if the product exceeds the total supply
+func (l Luna) Mul(n uint64) (Luna, error) {
+	hi, lo := bits.Mul64(uint64(l), n)
+	if hi != 0 {
+		return 0, ErrLunaOverflow
+	}
+	return checkSupply(Luna(lo))
+}
+
+// Div returns l / n rounded with the given rounding mode
+func (l Luna) Div(n uint64, mode RoundingMode) (Luna, error) {
+	return l.MulDiv(1, n, mode)
+}
+
+// MulDiv returns l * num / den rounded with the given rounding mode. The intermediate
+// product is not truncated, which allows fractional rates like a fee per byte.
+func (l Luna) MulDiv(num, den uint64, mode RoundingMode) (Luna, error) {
+	quo, err := mulDiv(uint64(l), num, den, mode)
+	if err != nil {
+		return 0, err
+	}
+	return checkSupply(Luna(quo))
+}
+
+// mulDiv returns x * num / den rounded with the given rounding mode, without the supply check
+func mulDiv(x, num, den uint64, mode RoundingMode) (uint64, error) {
+	if den == 0 {
+		return 0, ErrDivisionByZero
+	}
+
+	hi, lo := bits.Mul64(x, num)
+	if hi >= den {
+		return 0, ErrLunaOverflow
+	}
+	quo, rem := bits.Div64(hi, lo, den)
+
+	if roundUp(quo, rem, den, mode) {
+		if quo == ^uint64(0) {
+			return 0, ErrLunaOverflow
+		}
+		quo++
+	}
+	return quo, nil
+}
+
+// roundUp returns whether the quotient has to be incremented to honour the rounding mode
+func roundUp(quo, rem, den uint64, mode RoundingMode) bool {
+	if rem == 0 {
+		return false
+	}
+
+	// Compare the remainder to half of the denominator without overflowing
+	above := rem > den-rem
+	tie := rem == den-rem
+
+	switch mode {
+	case RoundUp:
+		return true
+	case RoundHalfUp:
+		return above || tie
+	case RoundHalfEven:
+		return above || tie && quo%2 == 1
+	default:
+		return false
+	}
+}
+
+func checkSupply(l Luna) (Luna, error) {
+	if l > MaxLuna {
+		return 0, fmt.Errorf("%w: %d luna", ErrExceedsSupply, l)
+	}
+	return l, nil
+}
+
+// ParseNIM parses an amount of NIM like "12.34567" into Luna. The amount is never truncated:
+// negative amounts, more than five decimals and amounts exceeding the total supply are rejected.
+func ParseNIM(s string) (Luna, error) {
+	s = strings.TrimSpace(s)
+
+	whole, fraction, _ := strings.Cut(s, ".")
+	if whole == "" && fraction == "" {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+	if !isDigits(whole) || !isDigits(fraction) {
+		return 0, fmt.Errorf("%w: %q is not a positive decimal number", ErrInvalidAmount, s)
+	}
+	if len(fraction) > LunaDecimals {
+		return 0, fmt.Errorf("%w: %q has more than %d decimals", ErrInvalidAmount, s, LunaDecimals)
+	}
+
+	var nim uint64
+	if whole != "" {
+		var err error
+		nim, err = strconv.ParseUint(whole, 10, 64)
+		if err != nil || nim > uint64(MaxLuna)/uint64(nimInLuna) {
+			return 0, fmt.Errorf("%w: %s NIM", ErrExceedsSupply, s)
+		}
+	}
+
+	var luna uint64
+	if fraction != "" {
+		fraction += strings.Repeat("0", LunaDecimals-len(fraction))
+		luna, _ = strconv.ParseUint(fraction, 10, 64)
+	}
+
+	return checkSupply(Luna(nim*uint64(nimInLuna) + luna))
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// FormatLuna is a function to format NIM to Luna, see ParseNIM for the accepted format
+func FormatLuna(n NIM) (Luna, error) {
+	return ParseNIM(string(n))
+}
+
+// ToNIM converts Luna to NIM
+func (l *Luna) ToNIM() NIM {
+	return FormatNIM(*l)
+}
+
+// NIM is the token transacted within Nimiq as a store and transfer of value: it acts as digital cash
+type NIM string
+
+// FormatNIM is a function to format Luna to NIM
+func FormatNIM(l Luna) NIM {
+	nim := decimal.NewFromBigInt(new(big.Int).SetUint64(uint64(l)), 0)
+	nim = nim.Div(decimal.NewFromInt(nimInLuna))
+	return NIM(nim.String())
+}
+
+// ToLuna converts NIM to Luna
+func (n *NIM) ToLuna() (Luna, error) {
+	return FormatLuna(*n)
+}
@@ -0,0 +1,163 @@
+package albatross
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLunaToNIM(t *testing.T) {
+	assert := assert.New(t)
+
+	// 0 luna is 0 NIM
+	assert.Equal(NIM("0"), FormatNIM(0), "NIM not formatted correctly")
+
+	// 1 luna is 0.00001 NIM
+	assert.Equal(NIM("0.00001"), FormatNIM(1), "NIM not formatted correctly")
+
+	// 100000 luna is 1 NIM
+	assert.Equal(NIM("1"), FormatNIM(100000), "NIM not formatted correctly")
+
+	// 1234567 is 12.34567 NIM
+	assert.Equal(NIM("12.34567"), FormatNIM(1234567), "NIM not formatted correctly")
+
+	// 1200000 is 12 NIM
+	assert.Equal(NIM("12"), FormatNIM(1200000), "NIM not formatted correctly")
+
+	// 123456789 is 1234.56789 NIM
+	assert.Equal(NIM("1234.56789"), FormatNIM(123456789), "NIM not formatted correctly")
+}
+
+func TestNIMToLuna(t *testing.T) {
+	// 0 NIM is 0 Luna
+	if l, _ := FormatLuna("0"); l != 0 {
+		t.Fail()
+	}
+	// 0.00001 NIM is 1 Luna
+	if l, _ := FormatLuna("0.00001"); l != 1 {
+		t.Fail()
+	}
+	// 1 NIM is 100000 Luna
+	if l, _ := FormatLuna("1"); l != 100000 {
+		t.Fail()
+	}
+	// 12.34567 NIM is 1234567 Luna
+	if l, _ := FormatLuna("12.34567"); l != 1234567 {
+		t.Fail()
+	}
+	// 12 NIM is 1200000 Luna
+	if l, _ := FormatLuna("12"); l != 1200000 {
+		t.Fail()
+	}
+	// 1234.56789 NIM is 123456789 Luna
+	if l, _ := FormatLuna("1234.56789"); l != 123456789 {
+		t.Fail()
+	}
+}
+
+// The Nimiq Network has been designed for a total supply of 21 Billion NIM.
+// The smallest unit of NIM is called Luna and 100’000 (1e5) Luna equal 1 NIM,
+// which results in a total supply of 21e14 Luna
+func TestMaxLuna(t *testing.T) {
+	max, _ := FormatLuna("21000000000")
+	if max != 2100000000000000 {
+		t.Fail()
+	}
+}
+
+func TestParseNIMStrict(t *testing.T) {
+	for _, valid := range []struct {
+		nim  string
+		luna Luna
+	}{
+		{"0.00001", 1},
+		{".5", 50000},
+		{"7.", 700000},
+		{" 21000000000 ", MaxLuna},
+	} {
+		l, err := ParseNIM(valid.nim)
+		assert.NoError(t, err, valid.nim)
+		assert.Equal(t, valid.luna, l, valid.nim)
+	}
+
+	for _, invalid := range []string{"", ".", "-1", "+1", "1e5", "0.000001", "1.2.3", "abc", "1 000"} {
+		_, err := ParseNIM(invalid)
+		assert.True(t, errors.Is(err, ErrInvalidAmount), "Expected %q to be rejected, got %v", invalid, err)
+	}
+
+	for _, tooLarge := range []string{"21000000000.00001", "99999999999999999999"} {
+		_, err := ParseNIM(tooLarge)
+		assert.True(t, errors.Is(err, ErrExceedsSupply), "Expected %q to exceed the supply, got %v", tooLarge, err)
+	}
+
+	_, err := FormatLuna("0.000001")
+	assert.Error(t, err, "FormatLuna should not truncate fractional luna")
+}
+
+func TestLunaArithmetic(t *testing.T) {
+	sum, err := Luna(100).Add(50)
+	assert.NoError(t, err)
+	assert.Equal(t, Luna(150), sum)
+
+	_, err = MaxLuna.Add(1)
+	assert.True(t, errors.Is(err, ErrExceedsSupply))
+
+	_, err = Luna(^uint64(0)).Add(1)
+	assert.True(t, errors.Is(err, ErrLunaOverflow))
+
+	diff, err := Luna(100).Sub(100)
+	assert.NoError(t, err)
+	assert.Equal(t, Luna(0), diff)
+
+	_, err = Luna(1).Sub(2)
+	assert.True(t, errors.Is(err, ErrLunaUnderflow))
+
+	product, err := Luna(138).Mul(3)
+	assert.NoError(t, err)
+	assert.Equal(t, Luna(414), product)
+
+	_, err = MaxLuna.Mul(2)
+	assert.True(t, errors.Is(err, ErrExceedsSupply))
+
+	_, err = Luna(1 << 40).Mul(1 << 40)
+	assert.True(t, errors.Is(err, ErrLunaOverflow))
+
+	_, err = Luna(1).Div(0, RoundDown)
+	assert.True(t, errors.Is(err, ErrDivisionByZero))
+}
+
+func TestLunaRounding(t *testing.T) {
+	tests := []struct {
+		luna     Luna
+		divisor  uint64
+		mode     RoundingMode
+		expected Luna
+	}{
+		{10, 4, RoundDown, 2},
+		{10, 4, RoundUp, 3},
+		{10, 4, RoundHalfUp, 3},
+		{10, 4, RoundHalfEven, 2},
+		{14, 4, RoundHalfEven, 4},
+		{11, 4, RoundHalfUp, 3},
+		{9, 4, RoundHalfUp, 2},
+		{9, 4, RoundUp, 3},
+		{8, 4, RoundUp, 2},
+	}
+
+	for _, test := range tests {
+		result, err := test.luna.Div(test.divisor, test.mode)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, result, "%d / %d with mode %d", test.luna, test.divisor, test.mode)
+	}
+
+	// A fee of 1.5 luna per byte for 139 bytes, rounded up to never underpay
+	fee, err := Luna(139).MulDiv(3, 2, RoundUp)
+	assert.NoError(t, err)
+	assert.Equal(t, Luna(209), fee)
+
+	// The intermediate product may exceed uint64 as long as the result does not
+	result, err := MaxLuna.MulDiv(1<<40, 1<<41, RoundDown)
+	assert.NoError(t, err)
+	assert.Equal(t, MaxLuna/2, result)
+}
@@ -0,0 +1,218 @@
+package albatross
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// Locale holds the rules to write amounts in a language or region
+type Locale struct {
+	DecimalSeparator string // Defaults to "."
+	GroupSeparator   string // Separator between groups of digits, empty to disable grouping
+	GroupSize        int    // Number of digits per group, defaults to 3
+}
+
+// Locales commonly used by Nimiq wallets. Custom locales can be defined as any other Locale value.
+var (
+	LocaleEnglish = Locale{DecimalSeparator: ".", GroupSeparator: ","}
+	LocaleGerman  = Locale{DecimalSeparator: ",", GroupSeparator: "."}
+	LocaleFrench  = Locale{DecimalSeparator: ",", GroupSeparator: " "}
+	LocaleSwiss   = Locale{DecimalSeparator: ".", GroupSeparator: "’"}
+)
+
+func (l Locale) decimalSeparator() string {
+	if l.DecimalSeparator != "" {
+		return l.DecimalSeparator
+	}
+	return "."
+}
+
+func (l Locale) groupSize() int {
+	if l.GroupSize > 0 {
+		return l.GroupSize
+	}
+	return 3
+}
+
+// compactSuffixes are the suffixes of the compact format, by powers of thousand NIM
+var compactSuffixes = []string{"", "K", "M", "B"}
+
+// NIMFormat determines how amounts of Luna are written as NIM
+type NIMFormat struct {
+	Locale    Locale
+	Decimals  int          // Number of decimals from 0 to 5, amounts are rounded to it with Rounding
+	TrimZeros bool         // Whether trailing zeros of the decimals are removed
+	Symbol    string       // Appended after a space if set, e.g. "NIM"
+	Compact   bool         // Whether large amounts are abbreviated, like 1.2K NIM
+	Rounding  RoundingMode // Rounding of amounts with more decimals than shown
+}
+
+// NewNIMFormat returns a format that writes all decimals of an amount, without trailing
+// zeros, followed by the NIM symbol, e.g. "1,234.56789 NIM" in english
+func NewNIMFormat(locale Locale) *NIMFormat {
+	return &NIMFormat{
+		Locale:    locale,
+		Decimals:  LunaDecimals,
+		TrimZeros: true,
+		Symbol:    "NIM",
+		Rounding:  RoundHalfUp,
+	}
+}
+
+func (f *NIMFormat) decimals() int {
+	if f.Decimals < 0 {
+		return 0
+	}
+	if f.Decimals > LunaDecimals {
+		return LunaDecimals
+	}
+	return f.Decimals
+}
+
+// Format writes an amount of Luna as NIM. Amounts above the total supply, like sums over
+// many accounts, are written as well.
+func (f *NIMFormat) Format(l Luna) string {
+	decimals := f.decimals()
+	scale := pow10(decimals)
+
+	// Amounts are scaled to units of the last shown decimal and rounded, in compact
+	// form additionally to the largest suffix that keeps at least one whole digit.
+	// The scale never exceeds the divisor, so the scaled amount cannot overflow.
+	exponent := 0
+	var units uint64
+	for {
+		divisor := uint64(nimInLuna) * pow1000(exponent)
+		units, _ = mulDiv(uint64(l), scale, divisor, f.Rounding)
+		if !f.Compact || exponent == len(compactSuffixes)-1 || units < 1000*scale {
+			break
+		}
+		exponent++
+	}
+
+	whole := units / scale
+	fraction := ""
+	if decimals > 0 {
+		fraction = fmt.Sprintf("%0*d", decimals, units%scale)
+	}
+	if f.TrimZeros {
+		fraction = strings.TrimRight(fraction, "0")
+	}
+
+	var b strings.Builder
+	b.WriteString(f.group(fmt.Sprintf("%d", whole)))
+	if fraction != "" {
+		b.WriteString(f.Locale.decimalSeparator())
+		b.WriteString(fraction)
+	}
+	b.WriteString(compactSuffixes[exponent])
+	if f.Symbol != "" {
+		b.WriteString(" ")
+		b.WriteString(f.Symbol)
+	}
+	return b.String()
+}
+
+func (f *NIMFormat) group(digits string) string {
+	size := f.Locale.groupSize()
+	if f.Locale.GroupSeparator == "" || len(digits) <= size {
+		return digits
+	}
+
+	var b strings.Builder
+	first := len(digits) % size
+	if first > 0 {
+		b.WriteString(digits[:first])
+	}
+	for i := first; i < len(digits); i += size {
+		if b.Len() > 0 {
+			b.WriteString(f.Locale.GroupSeparator)
+		}
+		b.WriteString(digits[i : i+size])
+	}
+	return b.String()
+}
+
+// Parse reads an amount written by Format back into Luna. The symbol and the group separators
+// are optional, but group separators are only accepted between complete groups. Compact amounts are accepted as long as they resolve to whole Luna.
+func (f *NIMFormat) Parse(s string) (Luna, error) {
+	amount := strings.TrimSpace(s)
+	if f.Symbol != "" && len(amount) >= len(f.Symbol) && strings.EqualFold(amount[len(amount)-len(f.Symbol):], f.Symbol) {
+		amount = strings.TrimSpace(amount[:len(amount)-len(f.Symbol)])
+	}
+
+	exponent := 0
+	for i := len(compactSuffixes) - 1; i > 0; i-- {
+		if len(amount) > 0 && strings.EqualFold(amount[len(amount)-1:], compactSuffixes[i]) {
+			exponent = i
+			amount = amount[:len(amount)-1]
+			break
+		}
+	}
+
+	whole, fraction, hasFraction := strings.Cut(amount, f.Locale.decimalSeparator())
+	whole, err := f.ungroup(whole)
+	if err != nil {
+		return 0, fmt.Errorf("parsing %q: %w", s, err)
+	}
+	normalized := whole
+	if hasFraction {
+		normalized += "." + fraction
+	}
+
+	if exponent == 0 {
+		l, err := ParseNIM(normalized)
+		if err != nil {
+			return 0, fmt.Errorf("parsing %q: %w", s, err)
+		}
+		return l, nil
+	}
+
+	if !isDigits(whole) || !isDigits(fraction) || whole == "" && fraction == "" {
+		return 0, fmt.Errorf("%w: %q is not a positive decimal number", ErrInvalidAmount, s)
+	}
+	nim, err := decimal.NewFromString(normalized)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+	luna := nim.Mul(decimal.NewFromInt(nimInLuna)).Mul(decimal.NewFromInt(int64(pow1000(exponent))))
+	if !luna.Equal(luna.Truncate(0)) {
+		return 0, fmt.Errorf("%w: %q has fractional luna", ErrInvalidAmount, s)
+	}
+	if luna.GreaterThan(decimal.NewFromInt(int64(MaxLuna))) {
+		return 0, fmt.Errorf("%w: %s", ErrExceedsSupply, s)
+	}
+	return Luna(luna.IntPart()), nil
+}
+
+// ungroup removes the group separators from the whole part of an amount. Every group except
+// the first one must have the full group size.
+func (f *NIMFormat) ungroup(whole string) (string, error) {
+	separator := f.Locale.GroupSeparator
+	if separator == "" || !strings.Contains(whole, separator) {
+		return whole, nil
+	}
+
+	size := f.Locale.groupSize()
+	groups := strings.Split(whole, separator)
+	for i, group := range groups {
+		if i == 0 && len(group) >= 1 && len(group) <= size || i > 0 && len(group) == size {
+			continue
+		}
+		return "", fmt.Errorf("%w: misplaced group separator in %q", ErrInvalidAmount, whole)
+	}
+	return strings.Join(groups, ""), nil
+}
+
+func pow10(n int) uint64 {
+	result := uint64(1)
+	for i := 0; i < n; i++ {
+		result *= 10
+	}
+	return result
+}
+
+func pow1000(n int) uint64 {
+	return pow10(3 * n)
+}
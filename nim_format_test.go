@@ -0,0 +1,145 @@
+package albatross
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatNIMLocales(t *testing.T) {
+	tests := []struct {
+		locale   Locale
+		luna     Luna
+		expected string
+	}{
+		{LocaleEnglish, 123456789, "1,234.56789 NIM"},
+		{LocaleEnglish, 100000, "1 NIM"},
+		{LocaleEnglish, 1, "0.00001 NIM"},
+		{LocaleEnglish, MaxLuna, "21,000,000,000 NIM"},
+		{LocaleEnglish, MaxLuna + 1, "21,000,000,000.00001 NIM"},
+		{LocaleEnglish, MaxLuna * 3, "63,000,000,000 NIM"},
+		{LocaleEnglish, Luna(^uint64(0)), "184,467,440,737,095.51615 NIM"},
+		{LocaleGerman, 123456789, "1.234,56789 NIM"},
+		{LocaleFrench, 123456789, "1 234,56789 NIM"},
+		{LocaleSwiss, 12345678900, "123’456.789 NIM"},
+		{Locale{DecimalSeparator: ".", GroupSeparator: ",", GroupSize: 4}, 12345678900, "12,3456.789 NIM"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, NewNIMFormat(test.locale).Format(test.luna))
+	}
+}
+
+func TestFormatNIMFixedDecimals(t *testing.T) {
+	f := NewNIMFormat(LocaleEnglish)
+	f.Decimals = 2
+	f.TrimZeros = false
+
+	assert.Equal(t, "1,234.57 NIM", f.Format(123456789))
+	assert.Equal(t, "1.00 NIM", f.Format(100000))
+
+	f.Rounding = RoundDown
+	assert.Equal(t, "1,234.56 NIM", f.Format(123456789))
+
+	f.Decimals = 0
+	f.Symbol = ""
+	assert.Equal(t, "1,234", f.Format(123456789))
+}
+
+func TestFormatNIMCompact(t *testing.T) {
+	f := NewNIMFormat(LocaleEnglish)
+	f.Compact = true
+	f.Decimals = 1
+
+	assert.Equal(t, "999 NIM", f.Format(99900000))
+	assert.Equal(t, "1.2K NIM", f.Format(123456789))
+	assert.Equal(t, "1M NIM", f.Format(99999000000))
+	assert.Equal(t, "21B NIM", f.Format(MaxLuna))
+	assert.Equal(t, "63B NIM", f.Format(MaxLuna*3))
+
+	f.Locale = LocaleGerman
+	assert.Equal(t, "1,2K NIM", f.Format(123456789))
+}
+
+func TestParseNIMFormats(t *testing.T) {
+	english := NewNIMFormat(LocaleEnglish)
+	german := NewNIMFormat(LocaleGerman)
+	french := NewNIMFormat(LocaleFrench)
+
+	tests := []struct {
+		format   *NIMFormat
+		amount   string
+		expected Luna
+	}{
+		{english, "1,234.56789 NIM", 123456789},
+		{english, "1234.56789", 123456789},
+		{english, " 1.2K nim ", 120000000},
+		{english, "21B", MaxLuna},
+		{german, "1.234,56789 NIM", 123456789},
+		{german, "0,5", 50000},
+		{english, "12,345,678.9", 1234567890000},
+		{french, "1\u202f234,5", 123450000},
+	}
+
+	for _, test := range tests {
+		l, err := test.format.Parse(test.amount)
+		assert.NoError(t, err, test.amount)
+		assert.Equal(t, test.expected, l, test.amount)
+	}
+
+	_, err := english.Parse("1.234,5 NIM")
+	assert.True(t, errors.Is(err, ErrInvalidAmount))
+
+	_, err = english.Parse("-1 NIM")
+	assert.True(t, errors.Is(err, ErrInvalidAmount))
+
+	_, err = english.Parse("0.000000001K")
+	assert.True(t, errors.Is(err, ErrInvalidAmount))
+
+	for _, misplaced := range []string{"1,2,3", "12,34", ",123", "1,234,", "1,,234", "1,2345.5"} {
+		_, err = english.Parse(misplaced)
+		assert.True(t, errors.Is(err, ErrInvalidAmount), misplaced)
+	}
+
+	_, err = english.Parse("22B NIM")
+	assert.True(t, errors.Is(err, ErrExceedsSupply))
+}
+
+func TestNIMFormatRoundTrip(t *testing.T) {
+	amounts := []Luna{0, 1, 99999, 100000, 123456789, 100000000001, MaxLuna}
+	locales := []Locale{LocaleEnglish, LocaleGerman, LocaleFrench, LocaleSwiss}
+
+	for _, locale := range locales {
+		f := NewNIMFormat(locale)
+		for _, amount := range amounts {
+			formatted := f.Format(amount)
+			parsed, err := f.Parse(formatted)
+			assert.NoError(t, err, formatted)
+			assert.Equal(t, amount, parsed, formatted)
+		}
+	}
+}
+
+func TestNIMFormatDefaults(t *testing.T) {
+	var zero NIMFormat
+	assert.Equal(t, "12345", zero.Format(1234500000))
+	parsed, err := zero.Parse("12345")
+	assert.NoError(t, err)
+	assert.Equal(t, Luna(1234500000), parsed, "The zero value format reads whole NIM")
+
+	custom := NewNIMFormat(Locale{GroupSeparator: "_"})
+	for _, amount := range []Luna{1, 123456789, MaxLuna} {
+		formatted := custom.Format(amount)
+		parsed, err := custom.Parse(formatted)
+		assert.NoError(t, err, formatted)
+		assert.Equal(t, amount, parsed, formatted)
+	}
+	assert.Equal(t, "1_234.56789 NIM", custom.Format(123456789))
+
+	empty := NewNIMFormat(Locale{})
+	assert.Equal(t, "1234.56789 NIM", empty.Format(123456789))
+	parsed, err = empty.Parse("1234.56789 NIM")
+	assert.NoError(t, err)
+	assert.Equal(t, Luna(123456789), parsed)
+}
@@ -0,0 +1,146 @@
+package albatross
+
+// Policy holds the constants of the Albatross consensus protocol as returned by the RPC server.
+// The block number arithmetic is performed offline, which avoids round trips to methods like
+// getEpochAt and getBatchAt. BlocksPerBatch and BatchesPerEpoch must be set before
+// using any of the methods.
+type Policy struct {
+	StakingContractAddress    Address `json:"stakingContractAddress"`
+	CoinbaseAddress           Address `json:"coinbaseAddress"`
+	TransactionValidityWindow int     `json:"transactionValidityWindow"`
+	MaxSizeMicroBody          int     `json:"maxSizeMicroBody"`
+	Version                   int     `json:"version"`
+	Slots                     int     `json:"slots"`
+	BlocksPerBatch            int     `json:"blocksPerBatch"`
+	BatchesPerEpoch           int     `json:"batchesPerEpoch"`
+	BlocksPerEpoch            int     `json:"blocksPerEpoch"`
+	ValidatorDeposit          Luna    `json:"validatorDeposit"`
+	TotalSupply               Luna    `json:"totalSupply"`
+
+	// GenesisBlockNumber is the number of the genesis block. Older nodes do not return it,
+	// in which case the genesis block is block 0.
+	GenesisBlockNumber int `json:"genesisBlockNumber"`
+}
+
+func (p *Policy) blocksPerEpoch() int {
+	if p.BlocksPerEpoch > 0 {
+		return p.BlocksPerEpoch
+	}
+	return p.BlocksPerBatch * p.BatchesPerEpoch
+}
+
+// sinceGenesis returns the number of blocks since genesis, or zero for blocks up to genesis
+func (p *Policy) sinceGenesis(blockNumber int) int {
+	if blockNumber <= p.GenesisBlockNumber {
+		return 0
+	}
+	return blockNumber - p.GenesisBlockNumber
+}
+
+// EpochAt returns the epoch of the given block. The genesis block belongs to epoch 0.
+func (p *Policy) EpochAt(blockNumber int) int {
+	blocks := p.sinceGenesis(blockNumber)
+	return (blocks + p.blocksPerEpoch() - 1) / p.blocksPerEpoch()
+}
+
+// EpochIndexAt returns the position of the given block within its epoch, starting at 0
+func (p *Policy) EpochIndexAt(blockNumber int) int {
+	blocks := p.sinceGenesis(blockNumber)
+	if blocks == 0 {
+		return 0
+	}
+	return (blocks + p.blocksPerEpoch() - 1) % p.blocksPerEpoch()
+}
+
+// BatchAt returns the batch of the given block. The genesis block belongs to batch 0.
+func (p *Policy) BatchAt(blockNumber int) int {
+	blocks := p.sinceGenesis(blockNumber)
+	return (blocks + p.BlocksPerBatch - 1) / p.BlocksPerBatch
+}
+
+// BatchIndexAt returns the position of the given block within its batch, starting at 0
+func (p *Policy) BatchIndexAt(blockNumber int) int {
+	blocks := p.sinceGenesis(blockNumber)
+	if blocks == 0 {
+		return 0
+	}
+	return (blocks + p.BlocksPerBatch - 1) % p.BlocksPerBatch
+}
+
+// IsMacroBlock returns whether the given block is a macro block, which is the last block of a batch
+func (p *Policy) IsMacroBlock(blockNumber int) bool {
+	return p.sinceGenesis(blockNumber)%p.BlocksPerBatch == 0
+}
+
+// IsMicroBlock returns whether the given block is a micro block
+func (p *Policy) IsMicroBlock(blockNumber int) bool {
+	return !p.IsMacroBlock(blockNumber)
+}
+
+// IsElectionBlock returns whether the given block is an election block, which is the last block of an epoch
+func (p *Policy) IsElectionBlock(blockNumber int) bool {
+	return p.sinceGenesis(blockNumber)%p.blocksPerEpoch() == 0
+}
+
+// FirstBlockOfEpoch returns the number of the first block of the given epoch
+func (p *Policy) FirstBlockOfEpoch(epoch int) int {
+	if epoch == 0 {
+		return p.GenesisBlockNumber
+	}
+	return p.GenesisBlockNumber + (epoch-1)*p.blocksPerEpoch() + 1
+}
+
+// LastBlockOfEpoch returns the number of the last block of the given epoch, which is its election block
+func (p *Policy) LastBlockOfEpoch(epoch int) int {
+	return p.GenesisBlockNumber + epoch*p.blocksPerEpoch()
+}
+
+// FirstBlockOfBatch returns the number of the first block of the given batch
+func (p *Policy) FirstBlockOfBatch(batch int) int {
+	if batch == 0 {
+		return p.GenesisBlockNumber
+	}
+	return p.GenesisBlockNumber + (batch-1)*p.BlocksPerBatch + 1
+}
+
+// LastBlockOfBatch returns the number of the last block of the given batch, which is its macro block
+func (p *Policy) LastBlockOfBatch(batch int) int {
+	return p.GenesisBlockNumber + batch*p.BlocksPerBatch
+}
+
+// MacroBlockAfter returns the number of the first macro block after the given block
+func (p *Policy) MacroBlockAfter(blockNumber int) int {
+	return p.GenesisBlockNumber + (p.sinceGenesis(blockNumber)/p.BlocksPerBatch+1)*p.BlocksPerBatch
+}
+
+// LastMacroBlock returns the number of the last macro block at or before the given block
+func (p *Policy) LastMacroBlock(blockNumber int) int {
+	return p.GenesisBlockNumber + p.sinceGenesis(blockNumber)/p.BlocksPerBatch*p.BlocksPerBatch
+}
+
+// ElectionBlockAfter returns the number of the first election block after the given block
+func (p *Policy) ElectionBlockAfter(blockNumber int) int {
+	return p.GenesisBlockNumber + (p.sinceGenesis(blockNumber)/p.blocksPerEpoch()+1)*p.blocksPerEpoch()
+}
+
+// LastElectionBlock returns the number of the last election block at or before the given block
+func (p *Policy) LastElectionBlock(blockNumber int) int {
+	return p.GenesisBlockNumber + p.sinceGenesis(blockNumber)/p.blocksPerEpoch()*p.blocksPerEpoch()
+}
+
+// BlocksRemainingInBatch returns the number of blocks following the given block in its batch
+func (p *Policy) BlocksRemainingInBatch(blockNumber int) int {
+	return p.LastBlockOfBatch(p.BatchAt(blockNumber)) - blockNumber
+}
+
+// BlocksRemainingInEpoch returns the number of blocks following the given block in its epoch
+func (p *Policy) BlocksRemainingInEpoch(blockNumber int) int {
+	return p.LastBlockOfEpoch(p.EpochAt(blockNumber)) - blockNumber
+}
+
+// GetPolicyConstants retrieves the policy constants of the node
+func (h *HttpClient) GetPolicyConstants() (*Policy, error) {
+	req := NewRPCRequest("getPolicyConstants")
+
+	return callAndUnwrapToPointer[Policy](h, req)
+}
@@ -0,0 +1,84 @@
+package albatross
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testPolicy has batches of 4 blocks and epochs of 3 batches
+var testPolicy = &Policy{
+	TransactionValidityWindow: 24,
+	BlocksPerBatch:            4,
+	BatchesPerEpoch:           3,
+	BlocksPerEpoch:            12,
+}
+
+func TestPolicyEpochAndBatch(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(0, testPolicy.EpochAt(0))
+	assert.Equal(1, testPolicy.EpochAt(1))
+	assert.Equal(1, testPolicy.EpochAt(12))
+	assert.Equal(2, testPolicy.EpochAt(13))
+	assert.Equal(0, testPolicy.EpochIndexAt(13))
+	assert.Equal(11, testPolicy.EpochIndexAt(24))
+
+	assert.Equal(0, testPolicy.BatchAt(0))
+	assert.Equal(1, testPolicy.BatchAt(4))
+	assert.Equal(2, testPolicy.BatchAt(5))
+	assert.Equal(3, testPolicy.BatchIndexAt(8))
+}
+
+func TestPolicyBlockTypes(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(testPolicy.IsMacroBlock(0))
+	assert.True(testPolicy.IsElectionBlock(0))
+	assert.True(testPolicy.IsMicroBlock(3))
+	assert.True(testPolicy.IsMacroBlock(8))
+	assert.False(testPolicy.IsElectionBlock(8))
+	assert.True(testPolicy.IsElectionBlock(24))
+}
+
+func TestPolicyBoundaries(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(13, testPolicy.FirstBlockOfEpoch(2))
+	assert.Equal(24, testPolicy.LastBlockOfEpoch(2))
+	assert.Equal(5, testPolicy.FirstBlockOfBatch(2))
+	assert.Equal(8, testPolicy.LastBlockOfBatch(2))
+
+	assert.Equal(8, testPolicy.MacroBlockAfter(4))
+	assert.Equal(4, testPolicy.LastMacroBlock(7))
+	assert.Equal(24, testPolicy.ElectionBlockAfter(12))
+	assert.Equal(12, testPolicy.LastElectionBlock(23))
+
+	assert.Equal(3, testPolicy.BlocksRemainingInBatch(5))
+	assert.Equal(0, testPolicy.BlocksRemainingInBatch(8))
+	assert.Equal(11, testPolicy.BlocksRemainingInEpoch(13))
+}
+
+func TestPolicyGenesisOffset(t *testing.T) {
+	policy := *testPolicy
+	policy.GenesisBlockNumber = 100
+	policy.BlocksPerEpoch = 0
+
+	assert.Equal(t, 0, policy.EpochAt(50))
+	assert.Equal(t, 1, policy.EpochAt(101))
+	assert.True(t, policy.IsElectionBlock(112))
+	assert.Equal(t, 113, policy.FirstBlockOfEpoch(2))
+	assert.Equal(t, 124, policy.ElectionBlockAfter(112))
+}
+
+func TestGetPolicyConstants(t *testing.T) {
+	mockResult := `{"stakingContractAddress":"NQ38 STAK 1NG0 0000 0000 C0NT RACT 0000 0000","transactionValidityWindow":7200,` +
+		`"blocksPerBatch":60,"batchesPerEpoch":720,"blocksPerEpoch":43200,"validatorDeposit":1000000000,"totalSupply":2100000000000000}`
+	client := newMockClient(t, "getPolicyConstants", `[]`, mockResult)
+
+	policy, err := client.GetPolicyConstants()
+	assert.NoError(t, err)
+	assert.Equal(t, 60, policy.BlocksPerBatch)
+	assert.Equal(t, Luna(1000000000), policy.ValidatorDeposit)
+	assert.Equal(t, 2, policy.EpochAt(43201))
+}
@@ -9,8 +9,11 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"time"
 )
 
+//go:generate go run ./internal/genrpc -schema openrpc.json -overlay openrpc-overlay.json -out rpc_generated.go
+
 var _ rpcClient = (*HttpClient)(nil)
 
 type rpcClient interface {
@@ -36,6 +39,23 @@ func callAndUnwrap[T any](client rpcClient, req *JsonRPCRequest) (T, error) {
 	return UnwrapObject[T](rpcResp)
 }
 
+// callAndDecode calls the given request and decodes the result with the given function,
+// which is used for results that are decoded into one of multiple types
+func callAndDecode[T any](client rpcClient, req *JsonRPCRequest, decode func([]byte) (T, error)) (T, error) {
+	rpcResp, err := client.Call(req)
+	if err != nil {
+		var emptyReturn T
+		return emptyReturn, err
+	}
+
+	if err := rpcResp.GetErr(); err != nil {
+		var emptyReturn T
+		return emptyReturn, err
+	}
+
+	return decode(rpcResp.GetWrapped())
+}
+
 func callAndUnwrapToPointer[T any](client rpcClient, req *JsonRPCRequest) (*T, error) {
 	data, err := callAndUnwrap[T](client, req)
 	if err != nil {
@@ -45,6 +65,24 @@ func callAndUnwrapToPointer[T any](client rpcClient, req *JsonRPCRequest) (*T, e
 	return &data, nil
 }
 
+// paramsProvider is implemented by the parameter structs of methods with many parameters
+type paramsProvider interface {
+	params() ([]interface{}, error)
+}
+
+// callWithParams validates the parameters and calls the given method with them
+func callWithParams[T any](client rpcClient, method string, p paramsProvider) (T, error) {
+	params, err := p.params()
+	if err != nil {
+		var emptyReturn T
+		return emptyReturn, err
+	}
+
+	req := NewRPCRequest(method, params...)
+
+	return callAndUnwrap[T](client, req)
+}
+
 type HttpClient struct {
 	client http.RoundTripper
 
@@ -186,59 +224,59 @@ func (h *HttpClient) GetEpochNumber() (epochNumber int, err error) {
 }
 
 // GetLatestBlock returns the latest block
-func (h *HttpClient) GetLatestBlock(includeFullTransactions ...bool) (*Block, error) {
+func (h *HttpClient) GetLatestBlock(includeFullTransactions ...bool) (Block, error) {
 	params := []interface{}{}
 	params = addOptionalParam(params, includeFullTransactions, false)
 	req := NewRPCRequest("getLatestBlock", params...)
 
-	return callAndUnwrapToPointer[Block](h, req)
+	return callAndDecode(h, req, UnmarshalBlock)
 }
 
 // GetBlockByNumber retrieves the desired block by number
-func (h *HttpClient) GetBlockByNumber(number int, includeFullTransactions ...bool) (*Block, error) {
+func (h *HttpClient) GetBlockByNumber(number int, includeFullTransactions ...bool) (Block, error) {
 	params := []interface{}{number}
 	params = addOptionalParam(params, includeFullTransactions, false)
 	req := NewRPCRequest("getBlockByNumber", params...)
 
-	return callAndUnwrapToPointer[Block](h, req)
+	return callAndDecode(h, req, UnmarshalBlock)
 }
 
 // GetBlockByHash retrieves the desired block by hash
-func (h *HttpClient) GetBlockByHash(hash string, includeFullTransactions ...bool) (*Block, error) {
+func (h *HttpClient) GetBlockByHash(hash Hash, includeFullTransactions ...bool) (Block, error) {
 	params := []interface{}{hash}
 	params = addOptionalParam(params, includeFullTransactions, false)
 	req := NewRPCRequest("getBlockByHash", params...)
 
-	return callAndUnwrapToPointer[Block](h, req)
+	return callAndDecode(h, req, UnmarshalBlock)
 }
 
 // GetTransactionByHash retrieves transaction by given hash
-func (h *HttpClient) GetTransactionByHash(hash string) (*Transaction, error) {
+func (h *HttpClient) GetTransactionByHash(hash Hash) (*Transaction, error) {
 	req := NewRPCRequest("getTransactionByHash", hash)
 
-	return callAndUnwrapToPointer[]()[Transaction](h, req)
+	return callAndUnwrapToPointer[Transaction](h, req)
 }
 
-// GetTransactionByBlockNumber retrieves all transaction in the given block
+// GetTransactionsByBlockNumber retrieves all transaction in the given block
 func (h *HttpClient) GetTransactionsByBlockNumber(blockNumber int) ([]*Transaction, error) {
-	req := NewRPCRequest("getTransactionByBlockNumber", blockNumber)
+	req := NewRPCRequest("getTransactionsByBlockNumber", blockNumber)
 
 	return callAndUnwrap[[]*Transaction](h, req)
 }
 
 // GetTransactionHashesByAddress retrieves all transaction hashes for a given account
-// Optionally max can be provided to limit the amount of returned hashes, default is 100. 
-func (h *HttpClient) GetTransactionHashesByAddress(address string, max ...int) ([]string, error) {
+// Optionally max can be provided to limit the amount of returned hashes, default is 100.
+func (h *HttpClient) GetTransactionHashesByAddress(address Address, max ...int) ([]Hash, error) {
 	params := []interface{}{address}
 	params = addOptionalParam(params, max, 100)
 	req := NewRPCRequest("getTransactionHashesByAddress", params...)
 
-	return callAndUnwrap[[]string](h, req)
+	return callAndUnwrap[[]Hash](h, req)
 }
 
 // GetTransactionsByAddress retrieves all transactions for a given account
-// Optionally max can be provided to limit the amount of returned transactions, default is 100. 
-func (h *HttpClient) GetTransactionsByAddress(address string, max ...int) ([]*Transaction, error) {
+// Optionally max can be provided to limit the amount of returned transactions, default is 100.
+func (h *HttpClient) GetTransactionsByAddress(address Address, max ...int) ([]*Transaction, error) {
 	params := []interface{}{address}
 	params = addOptionalParam(params, max, 100)
 	req := NewRPCRequest("getTransactionsByAddress", params...)
@@ -247,10 +285,11 @@ func (h *HttpClient) GetTransactionsByAddress(address string, max ...int) ([]*Tr
 }
 
 // GetAccountByAddress returns the desired account by address
-func (h *HttpClient) GetAccountByAddress(address string) (*Account, error) {
+// The returned account can be type switched to access the fields specific to its type.
+func (h *HttpClient) GetAccountByAddress(address Address) (Account, error) {
 	req := NewRPCRequest("getAccountByAddress", address)
 
-	return callAndUnwrapToPointer[Account](h, req)
+	return callAndDecode(h, req, UnmarshalAccount)
 }
 
 // CreateAccount creates a new basic account on the Nimiq blockchain
@@ -273,24 +312,29 @@ func (h *HttpClient) ImportAccountByRawKey(rawKey string, passphrase ...string)
 }
 
 // IsAccountImported returns whether the account is imported on the node
-func (h *HttpClient) IsAccountImported(address string) (bool, error) {
+func (h *HttpClient) IsAccountImported(address Address) (bool, error) {
 	req := NewRPCRequest("isAccountImported", address)
 
 	return callAndUnwrap[bool](h, req)
 }
 
 // LockAccount locks the given account on the node
-func (h *HttpClient) LockAccount(address string) error {
+func (h *HttpClient) LockAccount(address Address) error {
 	req := NewRPCRequest("lockAccount", address)
 
 	return callAndConfirm(h, req)
 }
 
-// UnlockAccount unlocks the given account on the node
-func (h *HttpClient) UnlockAccount(address string, passphrase ...string) error {
-	params := []interface{}{address}
-	params = addOptionalParam[string, interface{}](params, passphrase, nil)
-	params = append(params, nil) // Param for duration, which is currently not supported by the server
+// UnlockAccount unlocks the given account on the node. An empty passphrase is sent as no passphrase.
+// Optionally a duration can be provided after which the account is locked again, it is sent to
+// the node in milliseconds. Without duration the account stays unlocked until LockAccount is called.
+func (h *HttpClient) UnlockAccount(address Address, passphrase string, duration ...time.Duration) error {
+	params := []interface{}{address, optionalString(passphrase)}
+	if len(duration) > 0 {
+		params = append(params, duration[0].Milliseconds())
+	} else {
+		params = append(params, nil)
+	}
 
 	req := NewRPCRequest("unlockAccount", params...)
 
@@ -298,8 +342,38 @@ func (h *HttpClient) UnlockAccount(address string, passphrase ...string) error {
 }
 
 // IsAccountImported returns whether the account is imported on the node
-func (h *HttpClient) IsAccountUnlocked(address string) (bool, error) {
+func (h *HttpClient) IsAccountUnlocked(address Address) (bool, error) {
 	req := NewRPCRequest("isAccountUnlocked", address)
 
 	return callAndUnwrap[bool](h, req)
 }
+
+// ListAccounts returns the addresses of all accounts imported on the node
+func (h *HttpClient) ListAccounts() ([]Address, error) {
+	req := NewRPCRequest("listAccounts")
+
+	return callAndUnwrap[[]Address](h, req)
+}
+
+// RemoveAccount removes the given account from the node and returns whether it was removed
+func (h *HttpClient) RemoveAccount(address Address) (bool, error) {
+	req := NewRPCRequest("removeAccount", address)
+
+	return callAndUnwrap[bool](h, req)
+}
+
+// Sign signs a message with the given account, which must be imported on the node.
+// If isHex is true the message is interpreted as hex encoded bytes.
+func (h *HttpClient) Sign(message string, address Address, passphrase string, isHex bool) (*ReturnSignature, error) {
+	req := NewRPCRequest("sign", message, address, optionalString(passphrase), isHex)
+
+	return callAndUnwrapToPointer[ReturnSignature](h, req)
+}
+
+// VerifySignature verifies that the signature of the message was created by the owner of the public key.
+// If isHex is true the message is interpreted as hex encoded bytes.
+func (h *HttpClient) VerifySignature(message string, publicKey PublicKey, signature Signature, isHex bool) (bool, error) {
+	req := NewRPCRequest("verifySignature", message, publicKey, signature, isHex)
+
+	return callAndUnwrap[bool](h, req)
+}
@@ -0,0 +1,637 @@
+// Code generated by genrpc from openrpc.json and openrpc-overlay.json. DO NOT EDIT.
+
+package albatross
+
+// GetSlotAt returns the slot that produced the given block, optionally at the given view offset
+func (h *HttpClient) GetSlotAt(blockNumber int, offsetOpt ...int) (*Slot, error) {
+	params := []interface{}{blockNumber}
+	params = addOptionalParam[int, interface{}](params, offsetOpt, nil)
+	req := NewRPCRequest("getSlotAt", params...)
+
+	return callAndUnwrapToPointer[Slot](h, req)
+}
+
+// GetTransactionsByBatchNumber retrieves all transactions in the given batch
+func (h *HttpClient) GetTransactionsByBatchNumber(batchNumber int) ([]*Transaction, error) {
+	req := NewRPCRequest("getTransactionsByBatchNumber", batchNumber)
+
+	return callAndUnwrap[[]*Transaction](h, req)
+}
+
+// GetInherentsByBlockNumber retrieves all inherents applied in the given block
+func (h *HttpClient) GetInherentsByBlockNumber(blockNumber int) ([]*Inherent, error) {
+	req := NewRPCRequest("getInherentsByBlockNumber", blockNumber)
+
+	return callAndUnwrap[[]*Inherent](h, req)
+}
+
+// GetInherentsByBatchNumber retrieves all inherents applied in the given batch
+func (h *HttpClient) GetInherentsByBatchNumber(batchNumber int) ([]*Inherent, error) {
+	req := NewRPCRequest("getInherentsByBatchNumber", batchNumber)
+
+	return callAndUnwrap[[]*Inherent](h, req)
+}
+
+// GetActiveValidators returns the validators that are active in the current epoch
+func (h *HttpClient) GetActiveValidators() ([]*Validator, error) {
+	req := NewRPCRequest("getActiveValidators")
+
+	return callAndUnwrap[[]*Validator](h, req)
+}
+
+// GetCurrentSlashedSlots returns the slots slashed in the current batch
+func (h *HttpClient) GetCurrentSlashedSlots() (*SlashedSlots, error) {
+	req := NewRPCRequest("getCurrentSlashedSlots")
+
+	return callAndUnwrapToPointer[SlashedSlots](h, req)
+}
+
+// GetPreviousSlashedSlots returns the slots slashed in the previous batch
+func (h *HttpClient) GetPreviousSlashedSlots() (*SlashedSlots, error) {
+	req := NewRPCRequest("getPreviousSlashedSlots")
+
+	return callAndUnwrapToPointer[SlashedSlots](h, req)
+}
+
+// GetParkedValidators returns the validators that are parked
+func (h *HttpClient) GetParkedValidators() (*ParkedSet, error) {
+	req := NewRPCRequest("getParkedValidators")
+
+	return callAndUnwrapToPointer[ParkedSet](h, req)
+}
+
+// GetValidatorByAddress returns the validator with the given address
+func (h *HttpClient) GetValidatorByAddress(address Address, includeStakers ...bool) (*Validator, error) {
+	params := []interface{}{address}
+	params = addOptionalParam[bool, interface{}](params, includeStakers, nil)
+	req := NewRPCRequest("getValidatorByAddress", params...)
+
+	return callAndUnwrapToPointer[Validator](h, req)
+}
+
+// GetStakerByAddress returns the staker with the given address
+func (h *HttpClient) GetStakerByAddress(address Address) (*Staker, error) {
+	req := NewRPCRequest("getStakerByAddress", address)
+
+	return callAndUnwrapToPointer[Staker](h, req)
+}
+
+// GetRawTransactionInfo decodes the given hex encoded transaction
+func (h *HttpClient) GetRawTransactionInfo(rawTx string) (*Transaction, error) {
+	req := NewRPCRequest("getRawTransactionInfo", rawTx)
+
+	return callAndUnwrapToPointer[Transaction](h, req)
+}
+
+// SendRawTransaction sends the given hex encoded transaction to the network and returns its hash
+func (h *HttpClient) SendRawTransaction(rawTx string) (string, error) {
+	req := NewRPCRequest("sendRawTransaction", rawTx)
+
+	return callAndUnwrap[string](h, req)
+}
+
+// CreateBasicTransaction returns a hex encoded basic transaction
+func (h *HttpClient) CreateBasicTransaction(p *BasicTransactionParams) (string, error) {
+	return callWithParams[string](h, "createBasicTransaction", p)
+}
+
+// SendBasicTransaction sends a basic transaction and returns its hash
+func (h *HttpClient) SendBasicTransaction(p *BasicTransactionParams) (string, error) {
+	return callWithParams[string](h, "sendBasicTransaction", p)
+}
+
+// CreateBasicTransactionWithData returns a hex encoded basic transaction with data
+func (h *HttpClient) CreateBasicTransactionWithData(p *BasicTransactionWithDataParams) (string, error) {
+	return callWithParams[string](h, "createBasicTransactionWithData", p)
+}
+
+// SendBasicTransactionWithData sends a basic transaction with data and returns its hash
+func (h *HttpClient) SendBasicTransactionWithData(p *BasicTransactionWithDataParams) (string, error) {
+	return callWithParams[string](h, "sendBasicTransactionWithData", p)
+}
+
+// CreateNewVestingTransaction returns a hex encoded transaction that creates a vesting contract
+func (h *HttpClient) CreateNewVestingTransaction(p *NewVestingParams) (string, error) {
+	return callWithParams[string](h, "createNewVestingTransaction", p)
+}
+
+// SendNewVestingTransaction sends a transaction that creates a vesting contract and returns its hash
+func (h *HttpClient) SendNewVestingTransaction(p *NewVestingParams) (string, error) {
+	return callWithParams[string](h, "sendNewVestingTransaction", p)
+}
+
+// CreateRedeemVestingTransaction returns a hex encoded transaction that redeems funds from a vesting contract
+func (h *HttpClient) CreateRedeemVestingTransaction(p *RedeemVestingParams) (string, error) {
+	return callWithParams[string](h, "createRedeemVestingTransaction", p)
+}
+
+// SendRedeemVestingTransaction sends a transaction that redeems funds from a vesting contract and returns its hash
+func (h *HttpClient) SendRedeemVestingTransaction(p *RedeemVestingParams) (string, error) {
+	return callWithParams[string](h, "sendRedeemVestingTransaction", p)
+}
+
+// CreateNewHtlcTransaction returns a hex encoded transaction that creates a hashed time locked contract
+func (h *HttpClient) CreateNewHtlcTransaction(p *NewHtlcParams) (string, error) {
+	return callWithParams[string](h, "createNewHtlcTransaction", p)
+}
+
+// SendNewHtlcTransaction sends a transaction that creates a hashed time locked contract and returns its hash
+func (h *HttpClient) SendNewHtlcTransaction(p *NewHtlcParams) (string, error) {
+	return callWithParams[string](h, "sendNewHtlcTransaction", p)
+}
+
+// CreateRedeemRegularHtlcTransaction returns a hex encoded transaction that redeems a hashed time locked contract with a pre-image
+func (h *HttpClient) CreateRedeemRegularHtlcTransaction(p *RedeemRegularHtlcParams) (string, error) {
+	return callWithParams[string](h, "createRedeemRegularHtlcTransaction", p)
+}
+
+// SendRedeemRegularHtlcTransaction sends a transaction that redeems a hashed time locked contract with a pre-image and returns its hash
+func (h *HttpClient) SendRedeemRegularHtlcTransaction(p *RedeemRegularHtlcParams) (string, error) {
+	return callWithParams[string](h, "sendRedeemRegularHtlcTransaction", p)
+}
+
+// CreateRedeemTimeoutHtlcTransaction returns a hex encoded transaction that redeems a timed out hashed time locked contract
+func (h *HttpClient) CreateRedeemTimeoutHtlcTransaction(p *RedeemTimeoutHtlcParams) (string, error) {
+	return callWithParams[string](h, "createRedeemTimeoutHtlcTransaction", p)
+}
+
+// SendRedeemTimeoutHtlcTransaction sends a transaction that redeems a timed out hashed time locked contract and returns its hash
+func (h *HttpClient) SendRedeemTimeoutHtlcTransaction(p *RedeemTimeoutHtlcParams) (string, error) {
+	return callWithParams[string](h, "sendRedeemTimeoutHtlcTransaction", p)
+}
+
+// CreateRedeemEarlyHtlcTransaction returns a hex encoded transaction that redeems a hashed time locked contract early with signatures of both parties
+func (h *HttpClient) CreateRedeemEarlyHtlcTransaction(p *RedeemEarlyHtlcParams) (string, error) {
+	return callWithParams[string](h, "createRedeemEarlyHtlcTransaction", p)
+}
+
+// SendRedeemEarlyHtlcTransaction sends a transaction that redeems a hashed time locked contract early and returns its hash
+func (h *HttpClient) SendRedeemEarlyHtlcTransaction(p *RedeemEarlyHtlcParams) (string, error) {
+	return callWithParams[string](h, "sendRedeemEarlyHtlcTransaction", p)
+}
+
+// SignRedeemEarlyHtlcTransaction returns the hex encoded signature proof of the given account for an early redeem of a hashed time locked contract
+func (h *HttpClient) SignRedeemEarlyHtlcTransaction(p *RedeemEarlyHtlcSignParams) (string, error) {
+	return callWithParams[string](h, "signRedeemEarlyHtlcTransaction", p)
+}
+
+// GetEpochAt returns the epoch of the given block
+func (h *HttpClient) GetEpochAt(blockNumber int) (int, error) {
+	req := NewRPCRequest("getEpochAt", blockNumber)
+
+	return callAndUnwrap[int](h, req)
+}
+
+// GetEpochIndexAt returns the position of the given block within its epoch
+func (h *HttpClient) GetEpochIndexAt(blockNumber int) (int, error) {
+	req := NewRPCRequest("getEpochIndexAt", blockNumber)
+
+	return callAndUnwrap[int](h, req)
+}
+
+// GetBatchAt returns the batch of the given block
+func (h *HttpClient) GetBatchAt(blockNumber int) (int, error) {
+	req := NewRPCRequest("getBatchAt", blockNumber)
+
+	return callAndUnwrap[int](h, req)
+}
+
+// GetBatchIndexAt returns the position of the given block within its batch
+func (h *HttpClient) GetBatchIndexAt(blockNumber int) (int, error) {
+	req := NewRPCRequest("getBatchIndexAt", blockNumber)
+
+	return callAndUnwrap[int](h, req)
+}
+
+// GetElectionBlockAfter returns the number of the first election block after the given block
+func (h *HttpClient) GetElectionBlockAfter(blockNumber int) (int, error) {
+	req := NewRPCRequest("getElectionBlockAfter", blockNumber)
+
+	return callAndUnwrap[int](h, req)
+}
+
+// GetElectionBlockBefore returns the number of the last election block before the given block
+func (h *HttpClient) GetElectionBlockBefore(blockNumber int) (int, error) {
+	req := NewRPCRequest("getElectionBlockBefore", blockNumber)
+
+	return callAndUnwrap[int](h, req)
+}
+
+// GetLastElectionBlock returns the number of the last election block at or before the given block
+func (h *HttpClient) GetLastElectionBlock(blockNumber int) (int, error) {
+	req := NewRPCRequest("getLastElectionBlock", blockNumber)
+
+	return callAndUnwrap[int](h, req)
+}
+
+// GetMacroBlockAfter returns the number of the first macro block after the given block
+func (h *HttpClient) GetMacroBlockAfter(blockNumber int) (int, error) {
+	req := NewRPCRequest("getMacroBlockAfter", blockNumber)
+
+	return callAndUnwrap[int](h, req)
+}
+
+// GetMacroBlockBefore returns the number of the last macro block before the given block
+func (h *HttpClient) GetMacroBlockBefore(blockNumber int) (int, error) {
+	req := NewRPCRequest("getMacroBlockBefore", blockNumber)
+
+	return callAndUnwrap[int](h, req)
+}
+
+// GetLastMacroBlock returns the number of the last macro block at or before the given block
+func (h *HttpClient) GetLastMacroBlock(blockNumber int) (int, error) {
+	req := NewRPCRequest("getLastMacroBlock", blockNumber)
+
+	return callAndUnwrap[int](h, req)
+}
+
+// GetFirstBlockOf returns the number of the first block of the given epoch
+func (h *HttpClient) GetFirstBlockOf(epoch int) (int, error) {
+	req := NewRPCRequest("getFirstBlockOf", epoch)
+
+	return callAndUnwrap[int](h, req)
+}
+
+// GetFirstBlockOfBatch returns the number of the first block of the given batch
+func (h *HttpClient) GetFirstBlockOfBatch(batch int) (int, error) {
+	req := NewRPCRequest("getFirstBlockOfBatch", batch)
+
+	return callAndUnwrap[int](h, req)
+}
+
+// IsElectionBlockAt returns whether the given block is an election block
+func (h *HttpClient) IsElectionBlockAt(blockNumber int) (bool, error) {
+	req := NewRPCRequest("isElectionBlockAt", blockNumber)
+
+	return callAndUnwrap[bool](h, req)
+}
+
+// IsMacroBlockAt returns whether the given block is a macro block
+func (h *HttpClient) IsMacroBlockAt(blockNumber int) (bool, error) {
+	req := NewRPCRequest("isMacroBlockAt", blockNumber)
+
+	return callAndUnwrap[bool](h, req)
+}
+
+// IsMicroBlockAt returns whether the given block is a micro block
+func (h *HttpClient) IsMicroBlockAt(blockNumber int) (bool, error) {
+	req := NewRPCRequest("isMicroBlockAt", blockNumber)
+
+	return callAndUnwrap[bool](h, req)
+}
+
+// BasicTransactionParams holds the parameters of createBasicTransaction and sendBasicTransaction
+type BasicTransactionParams struct {
+	Wallet              Address // Address of the sending account, must be unlocked on the node
+	Recipient           Address
+	Value               Luna
+	Fee                 Luna
+	ValidityStartHeight ValidityStartHeight // Defaults to the current head of the node
+}
+
+func (p *BasicTransactionParams) params() ([]interface{}, error) {
+	if err := requireAddress("wallet", p.Wallet); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("value", p.Value, false); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("fee", p.Fee, true); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		p.Wallet,
+		p.Recipient,
+		p.Value,
+		p.Fee,
+		p.ValidityStartHeight,
+	}, nil
+}
+
+// BasicTransactionWithDataParams holds the parameters of createBasicTransactionWithData and sendBasicTransactionWithData
+type BasicTransactionWithDataParams struct {
+	Wallet              Address // Address of the sending account, must be unlocked on the node
+	Recipient           Address
+	Data                string // Hex encoded data
+	Value               Luna
+	Fee                 Luna
+	ValidityStartHeight ValidityStartHeight // Defaults to the current head of the node
+}
+
+func (p *BasicTransactionWithDataParams) params() ([]interface{}, error) {
+	if err := requireAddress("wallet", p.Wallet); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("value", p.Value, false); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("fee", p.Fee, true); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		p.Wallet,
+		p.Recipient,
+		p.Data,
+		p.Value,
+		p.Fee,
+		p.ValidityStartHeight,
+	}, nil
+}
+
+// NewVestingParams holds the parameters of createNewVestingTransaction and sendNewVestingTransaction
+type NewVestingParams struct {
+	Wallet              Address // Address of the sending account, must be unlocked on the node
+	Owner               Address // Address of the owner of the vesting contract
+	StartTime           int     // Unix timestamp in milliseconds from which the funds vest
+	TimeStep            int     // Milliseconds between vesting steps
+	NumSteps            int     // Number of vesting steps
+	Value               Luna
+	Fee                 Luna
+	ValidityStartHeight ValidityStartHeight // Defaults to the current head of the node
+}
+
+func (p *NewVestingParams) params() ([]interface{}, error) {
+	if err := requireAddress("wallet", p.Wallet); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("value", p.Value, false); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("fee", p.Fee, true); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		p.Wallet,
+		p.Owner,
+		p.StartTime,
+		p.TimeStep,
+		p.NumSteps,
+		p.Value,
+		p.Fee,
+		p.ValidityStartHeight,
+	}, nil
+}
+
+// RedeemVestingParams holds the parameters of createRedeemVestingTransaction and sendRedeemVestingTransaction
+type RedeemVestingParams struct {
+	Wallet              Address // Address of the owner of the vesting contract, must be unlocked on the node
+	ContractAddress     Address
+	Recipient           Address
+	Value               Luna
+	Fee                 Luna
+	ValidityStartHeight ValidityStartHeight // Defaults to the current head of the node
+}
+
+func (p *RedeemVestingParams) params() ([]interface{}, error) {
+	if err := requireAddress("wallet", p.Wallet); err != nil {
+		return nil, err
+	}
+	if err := requireAddress("contract", p.ContractAddress); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("value", p.Value, false); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("fee", p.Fee, true); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		p.Wallet,
+		p.ContractAddress,
+		p.Recipient,
+		p.Value,
+		p.Fee,
+		p.ValidityStartHeight,
+	}, nil
+}
+
+// NewHtlcParams holds the parameters of createNewHtlcTransaction and sendNewHtlcTransaction
+type NewHtlcParams struct {
+	Wallet              Address // Address of the sending account, must be unlocked on the node
+	HtlcSender          Address
+	HtlcRecipient       Address
+	HashRoot            HexBytes
+	HashCount           int
+	HashAlgorithm       HashAlgorithm
+	Timeout             int // Block height after which the contract times out
+	Value               Luna
+	Fee                 Luna
+	ValidityStartHeight ValidityStartHeight // Defaults to the current head of the node
+}
+
+func (p *NewHtlcParams) params() ([]interface{}, error) {
+	if err := requireAddress("wallet", p.Wallet); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("value", p.Value, false); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("fee", p.Fee, true); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		p.Wallet,
+		p.HtlcSender,
+		p.HtlcRecipient,
+		p.HashRoot,
+		p.HashCount,
+		p.HashAlgorithm,
+		p.Timeout,
+		p.Value,
+		p.Fee,
+		p.ValidityStartHeight,
+	}, nil
+}
+
+// RedeemRegularHtlcParams holds the parameters of createRedeemRegularHtlcTransaction and sendRedeemRegularHtlcTransaction
+type RedeemRegularHtlcParams struct {
+	Wallet              Address // Address of the recipient of the contract, must be unlocked on the node
+	ContractAddress     Address
+	Recipient           Address
+	PreImage            HexBytes
+	HashRoot            HexBytes
+	HashCount           int
+	HashAlgorithm       HashAlgorithm
+	Value               Luna
+	Fee                 Luna
+	ValidityStartHeight ValidityStartHeight // Defaults to the current head of the node
+}
+
+func (p *RedeemRegularHtlcParams) params() ([]interface{}, error) {
+	if err := requireAddress("wallet", p.Wallet); err != nil {
+		return nil, err
+	}
+	if err := requireAddress("contract", p.ContractAddress); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("value", p.Value, false); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("fee", p.Fee, true); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		p.Wallet,
+		p.ContractAddress,
+		p.Recipient,
+		p.PreImage,
+		p.HashRoot,
+		p.HashCount,
+		p.HashAlgorithm,
+		p.Value,
+		p.Fee,
+		p.ValidityStartHeight,
+	}, nil
+}
+
+// RedeemTimeoutHtlcParams holds the parameters of createRedeemTimeoutHtlcTransaction and sendRedeemTimeoutHtlcTransaction
+type RedeemTimeoutHtlcParams struct {
+	Wallet              Address // Address of the sender of the contract, must be unlocked on the node
+	ContractAddress     Address
+	Recipient           Address
+	Value               Luna
+	Fee                 Luna
+	ValidityStartHeight ValidityStartHeight // Defaults to the current head of the node
+}
+
+func (p *RedeemTimeoutHtlcParams) params() ([]interface{}, error) {
+	if err := requireAddress("wallet", p.Wallet); err != nil {
+		return nil, err
+	}
+	if err := requireAddress("contract", p.ContractAddress); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("value", p.Value, false); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("fee", p.Fee, true); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		p.Wallet,
+		p.ContractAddress,
+		p.Recipient,
+		p.Value,
+		p.Fee,
+		p.ValidityStartHeight,
+	}, nil
+}
+
+// RedeemEarlyHtlcParams holds the parameters of createRedeemEarlyHtlcTransaction and sendRedeemEarlyHtlcTransaction
+type RedeemEarlyHtlcParams struct {
+	ContractAddress        Address
+	Recipient              Address
+	HtlcSenderSignature    string // Hex encoded signature proof of the contract sender
+	HtlcRecipientSignature string // Hex encoded signature proof of the contract recipient
+	Value                  Luna
+	Fee                    Luna
+	ValidityStartHeight    ValidityStartHeight // Defaults to the current head of the node
+}
+
+func (p *RedeemEarlyHtlcParams) params() ([]interface{}, error) {
+	if err := requireAddress("contract", p.ContractAddress); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("value", p.Value, false); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("fee", p.Fee, true); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		p.ContractAddress,
+		p.Recipient,
+		p.HtlcSenderSignature,
+		p.HtlcRecipientSignature,
+		p.Value,
+		p.Fee,
+		p.ValidityStartHeight,
+	}, nil
+}
+
+// RedeemEarlyHtlcSignParams holds the parameters of signRedeemEarlyHtlcTransaction
+type RedeemEarlyHtlcSignParams struct {
+	Wallet              Address // Address of the signing party, must be unlocked on the node
+	ContractAddress     Address
+	Recipient           Address
+	Value               Luna
+	Fee                 Luna
+	ValidityStartHeight ValidityStartHeight // Defaults to the current head of the node
+}
+
+func (p *RedeemEarlyHtlcSignParams) params() ([]interface{}, error) {
+	if err := requireAddress("wallet", p.Wallet); err != nil {
+		return nil, err
+	}
+	if err := requireAddress("contract", p.ContractAddress); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("value", p.Value, false); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("fee", p.Fee, true); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		p.Wallet,
+		p.ContractAddress,
+		p.Recipient,
+		p.Value,
+		p.Fee,
+		p.ValidityStartHeight,
+	}, nil
+}
+
+// Inherent is an operation applied by the protocol rather than by a transaction, like rewards and slashes
+type Inherent struct {
+	Type        string   `json:"type"` // One of reward, slash, finalizeBatch or finalizeEpoch
+	BlockNumber int      `json:"blockNumber"`
+	Timestamp   int      `json:"timestamp"`
+	Target      Address  `json:"target"`
+	Value       Luna     `json:"value"`
+	Data        HexBytes `json:"data,omitempty"`
+	Hash        Hash     `json:"hash,omitempty"` // Hash of the reward transaction, only set for rewards
+}
+
+// ParkedSet holds the validators that are parked after producing a skip block
+type ParkedSet struct {
+	BlockNumber int       `json:"blockNumber"`
+	Validators  []Address `json:"validators"`
+}
+
+// SlashedSlots holds the slots that lost their rewards or were disabled in a batch
+type SlashedSlots struct {
+	BlockNumber int   `json:"blockNumber"`
+	LostRewards []int `json:"lostRewards"`
+	Disabled    []int `json:"disabled"`
+}
+
+// Staker represents a staker registered in the staking contract
+type Staker struct {
+	Address    Address `json:"address"`
+	Balance    Luna    `json:"balance"`
+	Delegation Address `json:"delegation,omitempty"` // Address of the validator the stake is delegated to
+}
+
+// Validator represents a validator registered in the staking contract
+type Validator struct {
+	Address        Address         `json:"address"`
+	SigningKey     string          `json:"signingKey"`
+	VotingKey      string          `json:"votingKey"`
+	RewardAddress  Address         `json:"rewardAddress"`
+	SignalData     HexBytes        `json:"signalData,omitempty"`
+	Balance        Luna            `json:"balance"`
+	NumStakers     int             `json:"numStakers"`
+	InactivityFlag int             `json:"inactivityFlag,omitempty"` // Block number at which the validator was deactivated
+	Retired        bool            `json:"retired"`
+	Stakers        map[string]Luna `json:"stakers,omitempty"` // Stake per staker address, only returned when requested
+}
@@ -0,0 +1,42 @@
+package albatross
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneratedParamsMethod(t *testing.T) {
+	params := `["` + testStaker + `","` + testValidator + `",500,1,"+0"]`
+	client := newMockClient(t, "sendBasicTransaction", params, testTxHash)
+
+	_, err := client.SendBasicTransaction(&BasicTransactionParams{
+		Wallet:    testStakerAddress,
+		Recipient: testValidatorAddress,
+		Value:     500,
+		Fee:       1,
+	})
+	assert.NoError(t, err)
+}
+
+func TestGeneratedOptionalParam(t *testing.T) {
+	mockResult := `{"address":"` + testValidator + `","balance":100,"numStakers":1,"retired":false,"stakers":{"` + testStaker + `":100}}`
+	client := newMockClient(t, "getValidatorByAddress", `["`+testValidator+`",true]`, mockResult)
+
+	validator, err := client.GetValidatorByAddress(testValidatorAddress, true)
+	assert.NoError(t, err)
+	assert.Equal(t, Luna(100), validator.Stakers[testStaker])
+}
+
+func TestGeneratedLunaValidation(t *testing.T) {
+	client := &HttpClient{}
+
+	_, err := client.SendBasicTransaction(&BasicTransactionParams{Wallet: testStakerAddress, Recipient: testValidatorAddress, Value: 0, Fee: 1})
+	assert.Error(t, err, "Zero value should be rejected")
+
+	_, err = client.CreateNewHtlcTransaction(&NewHtlcParams{Wallet: testStakerAddress, HtlcSender: testStakerAddress, HtlcRecipient: testValidatorAddress, Value: MaxLuna + 1})
+	assert.Error(t, err, "Value above total supply should be rejected")
+
+	_, err = client.SendNewVestingTransaction(&NewVestingParams{Wallet: testStakerAddress, Owner: testValidatorAddress, Value: 1, Fee: MaxLuna + 1})
+	assert.Error(t, err, "Fee above total supply should be rejected")
+}
@@ -0,0 +1,119 @@
+package albatross
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+)
+
+// MempoolInfo summarizes the transactions in the mempool of a node, grouped in buckets by fee per byte
+type MempoolInfo struct {
+	Total   int   `json:"total"`
+	Buckets []int `json:"buckets"` // Fee per byte thresholds of the buckets containing transactions
+
+	// Histogram maps the fee per byte threshold of a bucket to the number of transactions
+	// in that bucket. A transaction belongs to the bucket with the highest threshold not
+	// exceeding its fee per byte.
+	Histogram map[int]int `json:"-"`
+}
+
+// UnmarshalJSON decodes the mempool info, collecting the numeric bucket keys into the histogram
+func (m *MempoolInfo) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	m.Histogram = make(map[int]int)
+	for key, value := range fields {
+		var err error
+		switch key {
+		case "total":
+			err = json.Unmarshal(value, &m.Total)
+		case "buckets":
+			err = json.Unmarshal(value, &m.Buckets)
+		default:
+			feePerByte, convErr := strconv.Atoi(key)
+			if convErr != nil {
+				continue
+			}
+
+			var count *int
+			err = json.Unmarshal(value, &count)
+			if count != nil {
+				m.Histogram[feePerByte] = *count
+			}
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Thresholds returns the fee per byte thresholds of the histogram in ascending order
+func (m *MempoolInfo) Thresholds() []int {
+	thresholds := make([]int, 0, len(m.Histogram))
+	for feePerByte := range m.Histogram {
+		thresholds = append(thresholds, feePerByte)
+	}
+	sort.Ints(thresholds)
+	return thresholds
+}
+
+// CountAtOrAbove returns the number of transactions in buckets with a threshold of at least feePerByte.
+// Transactions in those buckets are prioritized over a transaction paying feePerByte.
+func (m *MempoolInfo) CountAtOrAbove(feePerByte int) int {
+	count := 0
+	for threshold, n := range m.Histogram {
+		if threshold >= feePerByte {
+			count += n
+		}
+	}
+	return count
+}
+
+// PushTransaction pushes a hex encoded transaction to the mempool of the node and returns its hash
+func (h *HttpClient) PushTransaction(rawTx string) (string, error) {
+	req := NewRPCRequest("pushTransaction", rawTx)
+
+	return callAndUnwrap[string](h, req)
+}
+
+// PushHighPriorityTransaction pushes a hex encoded transaction to the mempool of the node
+// with high priority and returns its hash
+func (h *HttpClient) PushHighPriorityTransaction(rawTx string) (string, error) {
+	req := NewRPCRequest("pushHighPriorityTransaction", rawTx)
+
+	return callAndUnwrap[string](h, req)
+}
+
+// MempoolContent returns the hashes of all transactions in the mempool
+func (h *HttpClient) MempoolContent() ([]string, error) {
+	req := NewRPCRequest("mempoolContent", false)
+
+	return callAndUnwrap[[]string](h, req)
+}
+
+// MempoolContentWithTransactions returns all transactions in the mempool
+func (h *HttpClient) MempoolContentWithTransactions() ([]*Transaction, error) {
+	req := NewRPCRequest("mempoolContent", true)
+
+	return callAndUnwrap[[]*Transaction](h, req)
+}
+
+// Mempool returns a summary of the mempool including the fee histogram
+func (h *HttpClient) Mempool() (*MempoolInfo, error) {
+	req := NewRPCRequest("mempool")
+
+	return callAndUnwrapToPointer[MempoolInfo](h, req)
+}
+
+// GetMinFeePerByte returns the minimum fee per byte in Luna that the node accepts in its mempool
+func (h *HttpClient) GetMinFeePerByte() (float64, error) {
+	req := NewRPCRequest("getMinFeePerByte")
+
+	return callAndUnwrap[float64](h, req)
+}
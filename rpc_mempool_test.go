@@ -0,0 +1,37 @@
+package albatross
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMempoolInfo(t *testing.T) {
+	mockResult := `{"0":3,"1":null,"2":5,"10":1,"total":9,"buckets":[10,2,0]}`
+	client := newMockClient(t, "mempool", `[]`, mockResult)
+
+	info, err := client.Mempool()
+	assert.NoError(t, err)
+	assert.Equal(t, 9, info.Total)
+	assert.Equal(t, []int{10, 2, 0}, info.Buckets)
+	assert.Equal(t, map[int]int{0: 3, 2: 5, 10: 1}, info.Histogram)
+	assert.Equal(t, []int{0, 2, 10}, info.Thresholds())
+	assert.Equal(t, 6, info.CountAtOrAbove(2))
+	assert.Equal(t, 0, info.CountAtOrAbove(11))
+}
+
+func TestMempoolContent(t *testing.T) {
+	client := newMockClient(t, "mempoolContent", `[false]`, `[`+testTxHash+`]`)
+
+	hashes, err := client.MempoolContent()
+	assert.NoError(t, err)
+	assert.Len(t, hashes, 1)
+}
+
+func TestGetMinFeePerByte(t *testing.T) {
+	client := newMockClient(t, "getMinFeePerByte", `[]`, `1.5`)
+
+	fee, err := client.GetMinFeePerByte()
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5, fee)
+}
@@ -0,0 +1,93 @@
+package albatross
+
+// NodeStatus is a snapshot of the consensus, chain and network state of a node.
+// The Albatross RPC server has no separate sync status method, isConsensusEstablished is the
+// only signal of whether the node is in sync, so Consensus doubles as the sync status.
+type NodeStatus struct {
+	Consensus   bool // Whether the node has established consensus and is in sync with the network
+	PeerCount   int  // Number of peers the node is connected to
+	BlockNumber int  // Number of the head block
+	BlockHash   Hash // Hash of the head block
+	Epoch       int  // Epoch of the head block
+	Batch       int  // Batch of the head block
+}
+
+// IsConsensusEstablished returns whether the node has established consensus with the network.
+// This is the sync status of the node: consensus is only established once the node has caught
+// up with the head of the chain, and it is lost again when the node falls behind.
+func (h *HttpClient) IsConsensusEstablished() (bool, error) {
+	req := NewRPCRequest("isConsensusEstablished")
+
+	return callAndUnwrap[bool](h, req)
+}
+
+// GetPeerId returns the peer ID of the node
+func (h *HttpClient) GetPeerId() (string, error) {
+	req := NewRPCRequest("getPeerId")
+
+	return callAndUnwrap[string](h, req)
+}
+
+// GetPeerCount returns the number of peers the node is connected to
+func (h *HttpClient) GetPeerCount() (int, error) {
+	req := NewRPCRequest("getPeerCount")
+
+	return callAndUnwrap[int](h, req)
+}
+
+// GetPeerList returns the peer IDs of all peers the node is connected to
+func (h *HttpClient) GetPeerList() ([]string, error) {
+	req := NewRPCRequest("getPeerList")
+
+	return callAndUnwrap[[]string](h, req)
+}
+
+// GetNodeStatus retrieves the consensus state, peer count and head block of the node
+// in a single batch request
+func (h *HttpClient) GetNodeStatus() (*NodeStatus, error) {
+	requests := []*JsonRPCRequest{
+		NewRPCRequestWithID("isConsensusEstablished", 1),
+		NewRPCRequestWithID("getPeerCount", 2),
+		NewRPCRequestWithID("getLatestBlock", 3, false),
+	}
+
+	responses, err := h.Batch(requests)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &NodeStatus{}
+
+	resp, err := findResponse(responses, 1)
+	if err != nil {
+		return nil, err
+	}
+	if status.Consensus, err = UnwrapObject[bool](resp); err != nil {
+		return nil, err
+	}
+
+	resp, err = findResponse(responses, 2)
+	if err != nil {
+		return nil, err
+	}
+	if status.PeerCount, err = UnwrapObject[int](resp); err != nil {
+		return nil, err
+	}
+
+	resp, err = findResponse(responses, 3)
+	if err != nil {
+		return nil, err
+	}
+	block, err := UnwrapBlock(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	header := block.Header()
+	status.BlockNumber = header.Number
+	status.BlockHash = header.Hash
+	status.Epoch = header.Epoch
+	status.Batch = header.Batch
+
+	return status, nil
+}
@@ -0,0 +1,63 @@
+package albatross
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetNodeStatus(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	callback := func(r *http.Request) error {
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expectedRawRequest := `[{"jsonrpc":"2.0","id":1,"method":"isConsensusEstablished","params":[]},` +
+			`{"jsonrpc":"2.0","id":2,"method":"getPeerCount","params":[]},` +
+			`{"jsonrpc":"2.0","id":3,"method":"getLatestBlock","params":[false]}]`
+		assert.Equal(t, expectedRawRequest, strings.TrimSpace(string(data)), "Request is invalid")
+		return nil
+	}
+
+	rpcClient := &HttpClient{
+		client: &testRoundtripper{
+			responseRecorder:  recorder,
+			roundtripCallback: callback,
+		},
+		url: "https://test.albatross.example",
+	}
+
+	// Responses are deliberately returned in a different order than requested
+	mockResponse := `[{"jsonrpc":"2.0","result":{"hash":"` + testHashA + `","number":1234,"epoch":2,"batch":20,"type":"micro"},"id":3},` +
+		`{"jsonrpc":"2.0","result":true,"id":1},` +
+		`{"jsonrpc":"2.0","result":8,"id":2}]`
+	recorder.WriteString(mockResponse)
+
+	status, err := rpcClient.GetNodeStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, &NodeStatus{
+		Consensus:   true,
+		PeerCount:   8,
+		BlockNumber: 1234,
+		BlockHash:   mustParseHash(t, testHashA),
+		Epoch:       2,
+		Batch:       20,
+	}, status)
+}
+
+func TestGetPeerList(t *testing.T) {
+	client := newMockClient(t, "getPeerList", `[]`, `["12D3KooWA","12D3KooWB"]`)
+
+	peers, err := client.GetPeerList()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"12D3KooWA", "12D3KooWB"}, peers)
+}
@@ -0,0 +1,212 @@
+package albatross
+
+// NewStakerParams holds the parameters of a transaction that registers a new staker
+type NewStakerParams struct {
+	SenderWallet Address // Address funding the stake, must be unlocked on the node
+	StakerWallet Address // Address of the new staker, must be unlocked on the node
+	Delegation   Address // Optional address of the validator to delegate the stake to
+
+	Value               Luna
+	Fee                 Luna
+	ValidityStartHeight ValidityStartHeight // Defaults to the current head of the node
+}
+
+func (p *NewStakerParams) params() ([]interface{}, error) {
+	if err := requireAddress("sender wallet", p.SenderWallet); err != nil {
+		return nil, err
+	}
+	if err := requireAddress("staker wallet", p.StakerWallet); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("value", p.Value, false); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("fee", p.Fee, true); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		p.SenderWallet,
+		p.StakerWallet,
+		optionalAddress(p.Delegation),
+		p.Value,
+		p.Fee,
+		p.ValidityStartHeight,
+	}, nil
+}
+
+// StakeParams holds the parameters of a transaction that adds stake to an existing staker
+type StakeParams struct {
+	SenderWallet  Address // Address funding the stake, must be unlocked on the node
+	StakerAddress Address // Address of the existing staker
+
+	Value               Luna
+	Fee                 Luna
+	ValidityStartHeight ValidityStartHeight // Defaults to the current head of the node
+}
+
+func (p *StakeParams) params() ([]interface{}, error) {
+	if err := requireAddress("sender wallet", p.SenderWallet); err != nil {
+		return nil, err
+	}
+	if err := requireAddress("staker", p.StakerAddress); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("value", p.Value, false); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("fee", p.Fee, true); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		p.SenderWallet,
+		p.StakerAddress,
+		p.Value,
+		p.Fee,
+		p.ValidityStartHeight,
+	}, nil
+}
+
+// UpdateStakerParams holds the parameters of a transaction that changes the delegation of a staker
+type UpdateStakerParams struct {
+	SenderWallet  Address // Address paying the fee, must be unlocked on the node
+	StakerWallet  Address // Address of the staker, must be unlocked on the node
+	NewDelegation Address // Optional address of the validator to delegate to, the zero address removes the delegation
+
+	Fee                 Luna
+	ValidityStartHeight ValidityStartHeight // Defaults to the current head of the node
+}
+
+func (p *UpdateStakerParams) params() ([]interface{}, error) {
+	if err := requireAddress("sender wallet", p.SenderWallet); err != nil {
+		return nil, err
+	}
+	if err := requireAddress("staker wallet", p.StakerWallet); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("fee", p.Fee, true); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		p.SenderWallet,
+		p.StakerWallet,
+		optionalAddress(p.NewDelegation),
+		p.Fee,
+		p.ValidityStartHeight,
+	}, nil
+}
+
+// UnstakeParams holds the parameters of a transaction that withdraws stake from a staker
+type UnstakeParams struct {
+	StakerWallet Address // Address of the staker, must be unlocked on the node
+	Recipient    Address // Address receiving the withdrawn stake, may be the burn address
+
+	Value               Luna
+	Fee                 Luna
+	ValidityStartHeight ValidityStartHeight // Defaults to the current head of the node
+}
+
+func (p *UnstakeParams) params() ([]interface{}, error) {
+	if err := requireAddress("staker wallet", p.StakerWallet); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("value", p.Value, false); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("fee", p.Fee, true); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		p.StakerWallet,
+		p.Recipient,
+		p.Value,
+		p.Fee,
+		p.ValidityStartHeight,
+	}, nil
+}
+
+// CreateNewStakerTransaction returns a hex encoded transaction that registers a new staker
+func (h *HttpClient) CreateNewStakerTransaction(p *NewStakerParams) (string, error) {
+	return callWithParams[string](h, "createNewStakerTransaction", p)
+}
+
+// SendNewStakerTransaction sends a transaction that registers a new staker and returns its hash
+func (h *HttpClient) SendNewStakerTransaction(p *NewStakerParams) (string, error) {
+	return callWithParams[string](h, "sendNewStakerTransaction", p)
+}
+
+// CreateStakeTransaction returns a hex encoded transaction that adds stake to a staker
+func (h *HttpClient) CreateStakeTransaction(p *StakeParams) (string, error) {
+	return callWithParams[string](h, "createStakeTransaction", p)
+}
+
+// SendStakeTransaction sends a transaction that adds stake to a staker and returns its hash
+func (h *HttpClient) SendStakeTransaction(p *StakeParams) (string, error) {
+	return callWithParams[string](h, "sendStakeTransaction", p)
+}
+
+// CreateUpdateStakerTransaction returns a hex encoded transaction that changes the delegation of a staker
+func (h *HttpClient) CreateUpdateStakerTransaction(p *UpdateStakerParams) (string, error) {
+	return callWithParams[string](h, "createUpdateStakerTransaction", p)
+}
+
+// SendUpdateStakerTransaction sends a transaction that changes the delegation of a staker and returns its hash
+func (h *HttpClient) SendUpdateStakerTransaction(p *UpdateStakerParams) (string, error) {
+	return callWithParams[string](h, "sendUpdateStakerTransaction", p)
+}
+
+// CreateUnstakeTransaction returns a hex encoded transaction that withdraws stake from a staker
+func (h *HttpClient) CreateUnstakeTransaction(p *UnstakeParams) (string, error) {
+	return callWithParams[string](h, "createUnstakeTransaction", p)
+}
+
+// SendUnstakeTransaction sends a transaction that withdraws stake from a staker and returns its hash
+func (h *HttpClient) SendUnstakeTransaction(p *UnstakeParams) (string, error) {
+	return callWithParams[string](h, "sendUnstakeTransaction", p)
+}
+
+// NewStaker registers the given address as staker delegating to validator.
+// The stake is funded by the staker itself, which must be unlocked on the node.
+func (h *HttpClient) NewStaker(stakerAddress, validator Address, value, fee Luna) (string, error) {
+	return h.SendNewStakerTransaction(&NewStakerParams{
+		SenderWallet: stakerAddress,
+		StakerWallet: stakerAddress,
+		Delegation:   validator,
+		Value:        value,
+		Fee:          fee,
+	})
+}
+
+// AddStake adds stake to the given staker, funded by the staker itself
+func (h *HttpClient) AddStake(stakerAddress Address, value, fee Luna) (string, error) {
+	return h.SendStakeTransaction(&StakeParams{
+		SenderWallet:  stakerAddress,
+		StakerAddress: stakerAddress,
+		Value:         value,
+		Fee:           fee,
+	})
+}
+
+// ChangeDelegation delegates the stake of the given staker to another validator.
+// The fee is paid by the staker itself.
+func (h *HttpClient) ChangeDelegation(stakerAddress, validator Address, fee Luna) (string, error) {
+	return h.SendUpdateStakerTransaction(&UpdateStakerParams{
+		SenderWallet:  stakerAddress,
+		StakerWallet:  stakerAddress,
+		NewDelegation: validator,
+		Fee:           fee,
+	})
+}
+
+// Unstake withdraws stake from the given staker back to the staker address
+func (h *HttpClient) Unstake(stakerAddress Address, value, fee Luna) (string, error) {
+	return h.SendUnstakeTransaction(&UnstakeParams{
+		StakerWallet: stakerAddress,
+		Recipient:    stakerAddress,
+		Value:        value,
+		Fee:          fee,
+	})
+}
@@ -0,0 +1,54 @@
+package albatross
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testStaker    = "NQ61 SH5J JP21 TM1U X10V H1QQ 681M FY9L DS6C"
+	testValidator = "NQ36 Y0MF 68B0 PH9H 2B51 32MT XMYS DYNL FSUG"
+	testTxHash    = `"21cfba017cf06251846eb5085e52a2388b2c4c05bd1b155063358ea63f75ac53"`
+)
+
+var (
+	testStakerAddress, _    = ParseAddress(testStaker)
+	testValidatorAddress, _ = ParseAddress(testValidator)
+)
+
+func TestNewStaker(t *testing.T) {
+	params := `["` + testStaker + `","` + testStaker + `","` + testValidator + `",100000,0,"+0"]`
+	client := newMockClient(t, "sendNewStakerTransaction", params, testTxHash)
+
+	hash, err := client.NewStaker(testStakerAddress, testValidatorAddress, 100000, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "21cfba017cf06251846eb5085e52a2388b2c4c05bd1b155063358ea63f75ac53", hash)
+}
+
+func TestCreateUpdateStakerWithoutDelegation(t *testing.T) {
+	params := `["` + testStaker + `","` + testStaker + `",null,10,"1234"]`
+	client := newMockClient(t, "createUpdateStakerTransaction", params, `"00aabb"`)
+
+	rawTx, err := client.CreateUpdateStakerTransaction(&UpdateStakerParams{
+		SenderWallet:        testStakerAddress,
+		StakerWallet:        testStakerAddress,
+		Fee:                 10,
+		ValidityStartHeight: AbsoluteValidityStartHeight(1234),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "00aabb", rawTx)
+}
+
+func TestStakingLunaValidation(t *testing.T) {
+	client := &HttpClient{}
+
+	_, err := client.AddStake(testStakerAddress, 0, 0)
+	assert.Error(t, err, "Zero stake should be rejected")
+
+	_, err = client.Unstake(testStakerAddress, MaxLuna+1, 0)
+	assert.Error(t, err, "Stake above total supply should be rejected")
+
+	_, err = client.CreateStakeTransaction(&StakeParams{StakerAddress: testStakerAddress, Value: 1})
+	assert.Error(t, err, "Missing sender wallet should be rejected")
+}
@@ -1,11 +1,13 @@
 package albatross
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -124,3 +126,74 @@ func TestRpcCallOverHttpRPCerror(t *testing.T) {
 	expectedErr := `JSON-RPC Error -32603 - Internal error. Error data: Multiple transactions found: 21cfba017cf06251846eb5085e52a2388b2c4c05bd1b155063358ea63f75ac53`
 	assert.Equal(t, err.Error(), expectedErr, "Returned error is invalid")
 }
+
+// newMockClient returns a HttpClient that verifies the method and params of each request
+// and responds with the given mock result
+func newMockClient(t *testing.T, method string, params string, mockResult string) *HttpClient {
+	recorder := httptest.NewRecorder()
+	callback := func(r *http.Request) error {
+		var req struct {
+			Method string          `json:"method"`
+			Params json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, method, req.Method, "Request method is invalid")
+		assert.JSONEq(t, params, string(req.Params), "Request params are invalid")
+
+		recorder.WriteString(`{"jsonrpc":"2.0","result":` + mockResult + `,"id":1}`)
+		return nil
+	}
+
+	return &HttpClient{
+		client: &testRoundtripper{
+			responseRecorder:  recorder,
+			roundtripCallback: callback,
+		},
+		url: "https://test.albatross.example",
+	}
+}
+
+func TestUnlockAccount(t *testing.T) {
+	client := newMockClient(t, "unlockAccount", `["`+testStaker+`","secret",null]`, `true`)
+	assert.NoError(t, client.UnlockAccount(testStakerAddress, "secret"))
+
+	client = newMockClient(t, "unlockAccount", `["`+testStaker+`",null,null]`, `true`)
+	assert.NoError(t, client.UnlockAccount(testStakerAddress, ""))
+}
+
+func TestUnlockAccountDuration(t *testing.T) {
+	client := newMockClient(t, "unlockAccount", `["`+testStaker+`","secret",60000]`, `true`)
+	assert.NoError(t, client.UnlockAccount(testStakerAddress, "secret", time.Minute))
+
+	client = newMockClient(t, "unlockAccount", `["`+testStaker+`",null,60000]`, `true`)
+	assert.NoError(t, client.UnlockAccount(testStakerAddress, "", time.Minute))
+}
+
+func TestSign(t *testing.T) {
+	mockResult := `{"publicKey":"aabb","signature":"ccdd"}`
+	client := newMockClient(t, "sign", `["hello","`+testStaker+`",null,false]`, mockResult)
+
+	signature, err := client.Sign("hello", testStakerAddress, "", false)
+	assert.NoError(t, err)
+	assert.Equal(t, &ReturnSignature{PublicKey: "aabb", Signature: "ccdd"}, signature)
+}
+
+func TestVerifySignature(t *testing.T) {
+	client := newMockClient(t, "verifySignature", `["hello","aabb","ccdd",false]`, `true`)
+
+	ok, err := client.VerifySignature("hello", "aabb", "ccdd", false)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestGetTransactionHashesByAddress(t *testing.T) {
+	mockResult := `["` + testHashA + `","` + testHashB + `"]`
+	client := newMockClient(t, "getTransactionHashesByAddress", `["`+testStaker+`",100]`, mockResult)
+
+	hashes, err := client.GetTransactionHashesByAddress(testStakerAddress)
+	assert.NoError(t, err)
+	assert.Equal(t, []Hash{mustParseHash(t, testHashA), mustParseHash(t, testHashB)}, hashes)
+}
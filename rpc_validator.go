@@ -0,0 +1,274 @@
+package albatross
+
+import "errors"
+
+// NewValidatorParams holds the parameters of a transaction that registers a new validator
+type NewValidatorParams struct {
+	SenderWallet     Address // Address paying the deposit and fee, must be unlocked on the node
+	ValidatorAddress Address // Address of the new validator, must be unlocked on the node
+	SigningSecretKey string  // Hex encoded Schnorr secret key used to sign blocks
+	VotingSecretKey  string  // Hex encoded BLS secret key used to vote on macro blocks
+	RewardAddress    Address // Address receiving the validator rewards, may be the burn address
+	SignalData       string  // Optional hex encoded signal data
+
+	Fee                 Luna
+	ValidityStartHeight ValidityStartHeight // Defaults to the current head of the node
+}
+
+func (p *NewValidatorParams) params() ([]interface{}, error) {
+	if err := requireAddress("sender wallet", p.SenderWallet); err != nil {
+		return nil, err
+	}
+	if err := requireAddress("validator", p.ValidatorAddress); err != nil {
+		return nil, err
+	}
+	if p.SigningSecretKey == "" || p.VotingSecretKey == "" {
+		return nil, errors.New("signing and voting secret keys are required")
+	}
+	if err := validateLuna("fee", p.Fee, true); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		p.SenderWallet,
+		p.ValidatorAddress,
+		p.SigningSecretKey,
+		p.VotingSecretKey,
+		p.RewardAddress,
+		optionalString(p.SignalData),
+		p.Fee,
+		p.ValidityStartHeight,
+	}, nil
+}
+
+// UpdateValidatorParams holds the parameters of a transaction that updates a validator.
+// Empty optional fields are left unchanged.
+type UpdateValidatorParams struct {
+	SenderWallet        Address // Address paying the fee, must be unlocked on the node
+	ValidatorAddress    Address // Address of the validator, must be unlocked on the node
+	NewSigningSecretKey string  // Optional
+	NewVotingSecretKey  string  // Optional
+	NewRewardAddress    Address // Optional
+	NewSignalData       string  // Optional
+
+	Fee                 Luna
+	ValidityStartHeight ValidityStartHeight // Defaults to the current head of the node
+}
+
+func (p *UpdateValidatorParams) params() ([]interface{}, error) {
+	if err := requireAddress("sender wallet", p.SenderWallet); err != nil {
+		return nil, err
+	}
+	if err := requireAddress("validator", p.ValidatorAddress); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("fee", p.Fee, true); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		p.SenderWallet,
+		p.ValidatorAddress,
+		optionalString(p.NewSigningSecretKey),
+		optionalString(p.NewVotingSecretKey),
+		optionalAddress(p.NewRewardAddress),
+		optionalString(p.NewSignalData),
+		p.Fee,
+		p.ValidityStartHeight,
+	}, nil
+}
+
+// ValidatorStateParams holds the parameters of a transaction that deactivates
+// or reactivates a validator
+type ValidatorStateParams struct {
+	SenderWallet     Address // Address paying the fee, must be unlocked on the node
+	ValidatorAddress Address // Address of the validator
+	SigningSecretKey string  // Hex encoded Schnorr secret key of the validator
+
+	Fee                 Luna
+	ValidityStartHeight ValidityStartHeight // Defaults to the current head of the node
+}
+
+func (p *ValidatorStateParams) params() ([]interface{}, error) {
+	if err := requireAddress("sender wallet", p.SenderWallet); err != nil {
+		return nil, err
+	}
+	if err := requireAddress("validator", p.ValidatorAddress); err != nil {
+		return nil, err
+	}
+	if p.SigningSecretKey == "" {
+		return nil, errors.New("signing secret key is required")
+	}
+	if err := validateLuna("fee", p.Fee, true); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		p.SenderWallet,
+		p.ValidatorAddress,
+		p.SigningSecretKey,
+		p.Fee,
+		p.ValidityStartHeight,
+	}, nil
+}
+
+// RetireValidatorParams holds the parameters of a transaction that retires a validator
+type RetireValidatorParams struct {
+	SenderWallet     Address // Address paying the fee, must be unlocked on the node
+	ValidatorAddress Address // Address of the validator, must be unlocked on the node
+
+	Fee                 Luna
+	ValidityStartHeight ValidityStartHeight // Defaults to the current head of the node
+}
+
+func (p *RetireValidatorParams) params() ([]interface{}, error) {
+	if err := requireAddress("sender wallet", p.SenderWallet); err != nil {
+		return nil, err
+	}
+	if err := requireAddress("validator", p.ValidatorAddress); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("fee", p.Fee, true); err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		p.SenderWallet,
+		p.ValidatorAddress,
+		p.Fee,
+		p.ValidityStartHeight,
+	}, nil
+}
+
+// DeleteValidatorParams holds the parameters of a transaction that deletes a retired
+// validator and returns its deposit
+type DeleteValidatorParams struct {
+	ValidatorAddress Address // Address of the validator, must be unlocked on the node
+	Recipient        Address // Address receiving the deposit, may be the burn address
+
+	Fee                 Luna
+	Value               Luna                // The deposit minus the fee
+	ValidityStartHeight ValidityStartHeight // Defaults to the current head of the node
+}
+
+func (p *DeleteValidatorParams) params() ([]interface{}, error) {
+	if err := requireAddress("validator", p.ValidatorAddress); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("fee", p.Fee, true); err != nil {
+		return nil, err
+	}
+	if err := validateLuna("value", p.Value, false); err != nil {
+		return nil, err
+	}
+
+	// The RPC server expects the fee before the value for this method
+	return []interface{}{
+		p.ValidatorAddress,
+		p.Recipient,
+		p.Fee,
+		p.Value,
+		p.ValidityStartHeight,
+	}, nil
+}
+
+// CreateNewValidatorTransaction returns a hex encoded transaction that registers a new validator
+func (h *HttpClient) CreateNewValidatorTransaction(p *NewValidatorParams) (string, error) {
+	return callWithParams[string](h, "createNewValidatorTransaction", p)
+}
+
+// SendNewValidatorTransaction sends a transaction that registers a new validator and returns its hash
+func (h *HttpClient) SendNewValidatorTransaction(p *NewValidatorParams) (string, error) {
+	return callWithParams[string](h, "sendNewValidatorTransaction", p)
+}
+
+// CreateUpdateValidatorTransaction returns a hex encoded transaction that updates a validator
+func (h *HttpClient) CreateUpdateValidatorTransaction(p *UpdateValidatorParams) (string, error) {
+	return callWithParams[string](h, "createUpdateValidatorTransaction", p)
+}
+
+// SendUpdateValidatorTransaction sends a transaction that updates a validator and returns its hash
+func (h *HttpClient) SendUpdateValidatorTransaction(p *UpdateValidatorParams) (string, error) {
+	return callWithParams[string](h, "sendUpdateValidatorTransaction", p)
+}
+
+// CreateDeactivateValidatorTransaction returns a hex encoded transaction that deactivates a validator
+func (h *HttpClient) CreateDeactivateValidatorTransaction(p *ValidatorStateParams) (string, error) {
+	return callWithParams[string](h, "createDeactivateValidatorTransaction", p)
+}
+
+// SendDeactivateValidatorTransaction sends a transaction that deactivates a validator and returns its hash
+func (h *HttpClient) SendDeactivateValidatorTransaction(p *ValidatorStateParams) (string, error) {
+	return callWithParams[string](h, "sendDeactivateValidatorTransaction", p)
+}
+
+// CreateInactivateValidatorTransaction is the equivalent of CreateDeactivateValidatorTransaction
+// for nodes that still expose the method under its former name
+func (h *HttpClient) CreateInactivateValidatorTransaction(p *ValidatorStateParams) (string, error) {
+	return callWithParams[string](h, "createInactivateValidatorTransaction", p)
+}
+
+// SendInactivateValidatorTransaction is the equivalent of SendDeactivateValidatorTransaction
+// for nodes that still expose the method under its former name
+func (h *HttpClient) SendInactivateValidatorTransaction(p *ValidatorStateParams) (string, error) {
+	return callWithParams[string](h, "sendInactivateValidatorTransaction", p)
+}
+
+// CreateReactivateValidatorTransaction returns a hex encoded transaction that reactivates a validator
+func (h *HttpClient) CreateReactivateValidatorTransaction(p *ValidatorStateParams) (string, error) {
+	return callWithParams[string](h, "createReactivateValidatorTransaction", p)
+}
+
+// SendReactivateValidatorTransaction sends a transaction that reactivates a validator and returns its hash
+func (h *HttpClient) SendReactivateValidatorTransaction(p *ValidatorStateParams) (string, error) {
+	return callWithParams[string](h, "sendReactivateValidatorTransaction", p)
+}
+
+// CreateRetireValidatorTransaction returns a hex encoded transaction that retires a validator
+func (h *HttpClient) CreateRetireValidatorTransaction(p *RetireValidatorParams) (string, error) {
+	return callWithParams[string](h, "createRetireValidatorTransaction", p)
+}
+
+// SendRetireValidatorTransaction sends a transaction that retires a validator and returns its hash
+func (h *HttpClient) SendRetireValidatorTransaction(p *RetireValidatorParams) (string, error) {
+	return callWithParams[string](h, "sendRetireValidatorTransaction", p)
+}
+
+// CreateDeleteValidatorTransaction returns a hex encoded transaction that deletes a retired validator
+func (h *HttpClient) CreateDeleteValidatorTransaction(p *DeleteValidatorParams) (string, error) {
+	return callWithParams[string](h, "createDeleteValidatorTransaction", p)
+}
+
+// SendDeleteValidatorTransaction sends a transaction that deletes a retired validator and returns its hash
+func (h *HttpClient) SendDeleteValidatorTransaction(p *DeleteValidatorParams) (string, error) {
+	return callWithParams[string](h, "sendDeleteValidatorTransaction", p)
+}
+
+// GetValidatorAddress returns the address of the validator running on the node
+func (h *HttpClient) GetValidatorAddress() (Address, error) {
+	req := NewRPCRequest("getAddress")
+
+	return callAndUnwrap[Address](h, req)
+}
+
+// GetSigningKey returns the hex encoded signing secret key of the validator running on the node
+func (h *HttpClient) GetSigningKey() (string, error) {
+	req := NewRPCRequest("getSigningKey")
+
+	return callAndUnwrap[string](h, req)
+}
+
+// GetVotingKey returns the hex encoded voting secret key of the validator running on the node
+func (h *HttpClient) GetVotingKey() (string, error) {
+	req := NewRPCRequest("getVotingKey")
+
+	return callAndUnwrap[string](h, req)
+}
+
+// SetAutomaticReactivation configures whether the validator running on the node
+// automatically reactivates itself after being deactivated
+func (h *HttpClient) SetAutomaticReactivation(automaticReactivate bool) error {
+	req := NewRPCRequest("setAutomaticReactivation", automaticReactivate)
+
+	return callAndConfirm(h, req)
+}
@@ -0,0 +1,62 @@
+package albatross
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateUpdateValidatorOptionalParams(t *testing.T) {
+	params := `["` + testStaker + `","` + testValidator + `",null,null,"` + testStaker + `",null,0,"+0"]`
+	client := newMockClient(t, "createUpdateValidatorTransaction", params, `"00aabb"`)
+
+	rawTx, err := client.CreateUpdateValidatorTransaction(&UpdateValidatorParams{
+		SenderWallet:     testStakerAddress,
+		ValidatorAddress: testValidatorAddress,
+		NewRewardAddress: testStakerAddress,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "00aabb", rawTx)
+}
+
+func TestSendDeleteValidatorParamOrder(t *testing.T) {
+	params := `["` + testValidator + `","` + testStaker + `",100,999900,"+0"]`
+	client := newMockClient(t, "sendDeleteValidatorTransaction", params, testTxHash)
+
+	_, err := client.SendDeleteValidatorTransaction(&DeleteValidatorParams{
+		ValidatorAddress: testValidatorAddress,
+		Recipient:        testStakerAddress,
+		Fee:              100,
+		Value:            999900,
+	})
+	assert.NoError(t, err)
+}
+
+func TestNewValidatorRequiresKeys(t *testing.T) {
+	client := &HttpClient{}
+	_, err := client.CreateNewValidatorTransaction(&NewValidatorParams{
+		SenderWallet:     testStakerAddress,
+		ValidatorAddress: testValidatorAddress,
+		RewardAddress:    testStakerAddress,
+	})
+	assert.Error(t, err)
+}
+
+func TestSetAutomaticReactivation(t *testing.T) {
+	client := newMockClient(t, "setAutomaticReactivation", `[true]`, `null`)
+	assert.NoError(t, client.SetAutomaticReactivation(true))
+}
+
+func TestCreateNewValidatorWithoutSignalData(t *testing.T) {
+	params := `["` + testStaker + `","` + testValidator + `","aa","bb","` + testStaker + `",null,0,"+0"]`
+	client := newMockClient(t, "createNewValidatorTransaction", params, `"00aabb"`)
+
+	_, err := client.CreateNewValidatorTransaction(&NewValidatorParams{
+		SenderWallet:     testStakerAddress,
+		ValidatorAddress: testValidatorAddress,
+		SigningSecretKey: "aa",
+		VotingSecretKey:  "bb",
+		RewardAddress:    testStakerAddress,
+	})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,117 @@
+package albatross
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"io/ioutil"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// schemaMethod is a method of the OpenRPC document describing the RPC interface
+type schemaMethod struct {
+	Name        string `json:"name"`
+	GoName      string `json:"x-go-name"`
+	Handwritten bool   `json:"x-go-handwritten"`
+}
+
+// openRPCMethods returns the methods of the OpenRPC document keyed by name
+func openRPCMethods(t *testing.T) map[string]schemaMethod {
+	data, err := ioutil.ReadFile("openrpc.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var doc struct {
+		Methods []schemaMethod `json:"methods"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	methods := make(map[string]schemaMethod)
+	for _, m := range doc.Methods {
+		methods[m.Name] = m
+	}
+	return methods
+}
+
+// TestHandwrittenMethodsInSchema verifies that every method called by the handwritten
+// wrappers exists in the OpenRPC document, which catches misspelled method names
+func TestHandwrittenMethodsInSchema(t *testing.T) {
+	methods := openRPCMethods(t)
+
+	fset := token.NewFileSet()
+	packages, err := parser.ParseDir(fset, ".", func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, file := range packages["albatross"].Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || !isRequestCall(call.Fun) {
+				return true
+			}
+
+			for _, arg := range call.Args {
+				lit, ok := arg.(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					continue
+				}
+
+				method, _ := strconv.Unquote(lit.Value)
+				if _, ok := methods[method]; !ok {
+					t.Errorf("%s: method %q is not part of openrpc.json", fset.Position(lit.Pos()), method)
+				}
+				break
+			}
+			return true
+		})
+	}
+}
+
+// TestHandwrittenMethodsImplemented verifies that every method marked as handwritten
+// in the OpenRPC document is implemented by HttpClient
+func TestHandwrittenMethodsImplemented(t *testing.T) {
+	clientType := reflect.TypeOf(&HttpClient{})
+
+	for name, method := range openRPCMethods(t) {
+		if !method.Handwritten {
+			continue
+		}
+
+		goName := method.GoName
+		if goName == "" {
+			goName = strings.ToUpper(name[:1]) + name[1:]
+		}
+		if _, ok := clientType.MethodByName(goName); !ok {
+			t.Errorf("method %s is marked as handwritten but HttpClient.%s does not exist", name, goName)
+		}
+	}
+}
+
+// isRequestCall returns whether the called function takes an RPC method name
+func isRequestCall(fun ast.Expr) bool {
+	if index, ok := fun.(*ast.IndexExpr); ok {
+		fun = index.X
+	}
+
+	ident, ok := fun.(*ast.Ident)
+	if !ok {
+		return false
+	}
+
+	switch ident.Name {
+	case "NewRPCRequest", "NewRPCRequestWithID", "callWithParams":
+		return true
+	}
+	return false
+}
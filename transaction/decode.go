@@ -0,0 +1,185 @@
+package transaction
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/redmaner/albatross-go"
+)
+
+// ErrInvalidSignature is returned when the proof of a transaction does not match its content or sender
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// String returns the name of the network
+func (n NetworkID) String() string {
+	switch n {
+	case NetworkTestAlbatross:
+		return "TestAlbatross"
+	case NetworkDevAlbatross:
+		return "DevAlbatross"
+	case NetworkUnitAlbatross:
+		return "UnitAlbatross"
+	case NetworkMainAlbatross:
+		return "MainAlbatross"
+	}
+	return fmt.Sprintf("NetworkID(%d)", uint8(n))
+}
+
+// ParseHex decodes a hex encoded transaction, as accepted by SendRawTransaction
+func ParseHex(s string) (*Transaction, error) {
+	data, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(s), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTransaction, err)
+	}
+	return Parse(data)
+}
+
+// Parse decodes a serialized transaction in the basic or extended format
+func Parse(data []byte) (*Transaction, error) {
+	d := &decoder{r: bytes.NewReader(data)}
+	tx := &Transaction{}
+
+	switch format := Format(d.byte()); format {
+	case FormatBasic:
+		proof := &SignatureProof{}
+		d.read(proof.PublicKey[:])
+		d.read(tx.Recipient[:])
+		tx.Value = albatross.Luna(d.uint64())
+		tx.Fee = albatross.Luna(d.uint64())
+		tx.ValidityStartHeight = d.uint32()
+		tx.NetworkID = NetworkID(d.byte())
+		d.read(proof.Signature[:])
+
+		tx.Sender = proof.PublicKey.Address()
+		tx.SenderType = AccountTypeBasic
+		tx.RecipientType = AccountTypeBasic
+		tx.Proof = proof.Serialize()
+	case FormatExtended:
+		d.read(tx.Sender[:])
+		tx.SenderType = AccountType(d.byte())
+		tx.SenderData = d.withLength()
+		d.read(tx.Recipient[:])
+		tx.RecipientType = AccountType(d.byte())
+		tx.Data = d.withLength()
+		tx.Value = albatross.Luna(d.uint64())
+		tx.Fee = albatross.Luna(d.uint64())
+		tx.ValidityStartHeight = d.uint32()
+		tx.NetworkID = NetworkID(d.byte())
+		tx.Flags = Flags(d.byte())
+		tx.Proof = d.withLength()
+	default:
+		if d.err == nil {
+			return nil, fmt.Errorf("%w: unknown format %d", ErrInvalidTransaction, format)
+		}
+	}
+
+	if d.err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTransaction, d.err)
+	}
+	if d.r.Len() > 0 {
+		return nil, fmt.Errorf("%w: %d trailing bytes", ErrInvalidTransaction, d.r.Len())
+	}
+	if err := tx.validate(); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// SignatureProof decodes the proof of the transaction
+func (tx *Transaction) SignatureProof() (*SignatureProof, error) {
+	return ParseSignatureProof(tx.Proof)
+}
+
+// Verify checks that the proof contains a valid signature of the transaction content.
+// For basic senders the proof must also sign for the sender, either with the key of the sender
+// or with a merkle path to the sender as multisig account. The owner of a contract cannot be
+// checked offline, so only the signature is verified for vesting and staking senders. HTLC
+// proofs are not supported.
+func (tx *Transaction) Verify() error {
+	if tx.SenderType == AccountTypeHTLC {
+		return fmt.Errorf("%w: HTLC proofs cannot be verified", ErrInvalidProof)
+	}
+
+	proof, err := tx.SignatureProof()
+	if err != nil {
+		return err
+	}
+
+	if signer := proof.Signer(); tx.SenderType == AccountTypeBasic && signer != tx.Sender {
+		return fmt.Errorf("%w: proof signs for %s instead of sender %s", ErrInvalidSignature, signer, tx.Sender)
+	}
+
+	content, err := tx.SerializeContent()
+	if err != nil {
+		return err
+	}
+	if !proof.Verify(content) {
+		return fmt.Errorf("%w: signature does not match the transaction", ErrInvalidSignature)
+	}
+	return nil
+}
+
+// RPCTransaction returns the transaction in the form returned by the RPC server, with the hash
+// recomputed from its content. Block related fields are not set.
+func (tx *Transaction) RPCTransaction() (*albatross.Transaction, error) {
+	hash, err := tx.Hash()
+	if err != nil {
+		return nil, err
+	}
+
+	return &albatross.Transaction{
+		Hash:                hash,
+		FromAddress:         tx.Sender,
+		ToAddress:           tx.Recipient,
+		Value:               tx.Value,
+		Fee:                 tx.Fee,
+		Data:                tx.Data,
+		Flags:               int(tx.Flags),
+		ValidityStartHeight: int(tx.ValidityStartHeight),
+		Proof:               tx.Proof,
+	}, nil
+}
+
+// decoder reads big endian values and remembers the first error
+type decoder struct {
+	r   *bytes.Reader
+	err error
+}
+
+func (d *decoder) read(dst []byte) {
+	if d.err == nil {
+		_, d.err = io.ReadFull(d.r, dst)
+	}
+}
+
+func (d *decoder) byte() byte {
+	var b [1]byte
+	d.read(b[:])
+	return b[0]
+}
+
+func (d *decoder) uint32() uint32 {
+	var b [4]byte
+	d.read(b[:])
+	return binary.BigEndian.Uint32(b[:])
+}
+
+func (d *decoder) uint64() uint64 {
+	var b [8]byte
+	d.read(b[:])
+	return binary.BigEndian.Uint64(b[:])
+}
+
+func (d *decoder) withLength() []byte {
+	var b [2]byte
+	d.read(b[:])
+	data := make([]byte, binary.BigEndian.Uint16(b[:]))
+	d.read(data)
+	return data
+}
@@ -0,0 +1,107 @@
+package transaction
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/redmaner/albatross-go"
+	"github.com/redmaner/albatross-go/keys"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBasicTransaction(t *testing.T) {
+	tx, err := ParseHex(testBasicHex)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, FormatBasic, tx.Format())
+	assert.Equal(t, testKey(t).Address(), tx.Sender)
+	assert.Equal(t, "NQ61 SH5J JP21 TM1U X10V H1QQ 681M FY9L DS6C", tx.Recipient.String())
+	assert.Equal(t, albatross.Luna(100000), tx.Value)
+	assert.Equal(t, albatross.Luna(138), tx.Fee)
+	assert.Equal(t, uint32(1000), tx.ValidityStartHeight)
+	assert.Equal(t, "TestAlbatross", tx.NetworkID.String())
+	assert.NoError(t, tx.Verify())
+
+	proof, err := tx.SignatureProof()
+	assert.NoError(t, err)
+	assert.Equal(t, testKey(t).PublicKey, proof.PublicKey)
+
+	info, err := tx.RPCTransaction()
+	assert.NoError(t, err)
+	assert.Equal(t, testBasicHash, info.Hash.String())
+	assert.Equal(t, tx.Sender, info.FromAddress)
+
+	raw, err := tx.Hex()
+	assert.NoError(t, err)
+	assert.Equal(t, testBasicHex, raw, "Decoding and encoding should round trip")
+}
+
+func TestParseExtendedTransaction(t *testing.T) {
+	tx, err := ParseHex("0x" + testExtendedHex)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, FormatExtended, tx.Format())
+	assert.Equal(t, AccountTypeStaking, tx.RecipientType)
+	assert.Equal(t, FlagSignaling, tx.Flags)
+	assert.Equal(t, []byte{4, 5}, tx.SenderData)
+	assert.Equal(t, []byte{1, 2, 3}, tx.Data)
+	assert.NoError(t, tx.Verify())
+
+	hash, err := tx.Hash()
+	assert.NoError(t, err)
+	assert.Equal(t, testExtendedHash, hash.String())
+
+	raw, err := tx.Hex()
+	assert.NoError(t, err)
+	assert.Equal(t, testExtendedHex, raw)
+}
+
+func TestVerifyTamperedTransaction(t *testing.T) {
+	tx, _ := ParseHex(testBasicHex)
+	tx.Value++
+	assert.True(t, errors.Is(tx.Verify(), ErrInvalidSignature))
+
+	tx, _ = ParseHex(testExtendedHex)
+	tx.Sender[0] ^= 1
+	assert.True(t, errors.Is(tx.Verify(), ErrInvalidSignature), "Proof key must belong to a basic sender")
+}
+
+func TestVerifyMerklePathSender(t *testing.T) {
+	sender := testAddress(t, "NQ61 SH5J JP21 TM1U X10V H1QQ 681M FY9L DS6C")
+	forger, err := keys.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A proof of an unrelated key with a dummy merkle path must not sign for the sender
+	tx := NewBasicTransaction(sender, sender, 1, 0, 1, NetworkTestAlbatross)
+	content, _ := tx.SerializeContent()
+	proof, _ := NewSignatureProof(forger.PublicKey, forger.Sign(content))
+	proof.MerklePath = []MerklePathNode{{Left: true}}
+	tx.Proof = proof.Serialize()
+	assert.True(t, errors.Is(tx.Verify(), ErrInvalidSignature), "Forged multisig proof should be rejected")
+
+	// A multisig account is the root of the merkle path of its keys
+	tx.Sender = proof.Signer()
+	content, _ = tx.SerializeContent()
+	proof, _ = NewSignatureProof(forger.PublicKey, forger.Sign(content))
+	proof.MerklePath = []MerklePathNode{{Left: true}}
+	tx.Proof = proof.Serialize()
+	assert.NoError(t, tx.Verify())
+
+	tx.SenderType = AccountTypeHTLC
+	assert.True(t, errors.Is(tx.Verify(), ErrInvalidProof))
+}
+
+func TestParseInvalidTransaction(t *testing.T) {
+	for _, invalid := range []string{"", "zz", "02", testBasicHex[:len(testBasicHex)-2], testBasicHex + "00"} {
+		_, err := ParseHex(invalid)
+		assert.True(t, errors.Is(err, ErrInvalidTransaction), "Expected %q to be invalid, got %v", invalid, err)
+	}
+
+	assert.Equal(t, "NetworkID(99)", NetworkID(99).String())
+}
@@ -0,0 +1,154 @@
+package transaction
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/redmaner/albatross-go"
+	"github.com/redmaner/albatross-go/keys"
+)
+
+// Priority is how quickly a transaction should be included in a block
+type Priority int
+
+const (
+	PriorityLow    Priority = iota // Pays the minimum fee accepted by the node
+	PriorityNormal                 // Matches the median transaction in the mempool
+	PriorityHigh                   // Matches the best paying transactions in the mempool
+)
+
+// feePerBytePrecision is the precision of fees per byte in fractions of a Luna. Fees per byte
+// are rounded to it, the fee of a transaction is then rounded up to whole Luna.
+const feePerBytePrecision = 1000000
+
+// singleSignatureProofSize is the size of a proof signed by a single key:
+// algorithm, public key, empty merkle path and signature
+const singleSignatureProofSize = 1 + keys.PublicKeyLength + 1 + keys.SignatureLength
+
+// FeeSource provides the fee data of a node, it is implemented by HttpClient
+type FeeSource interface {
+	GetMinFeePerByte() (float64, error)
+	Mempool() (*albatross.MempoolInfo, error)
+}
+
+var _ FeeSource = (*albatross.HttpClient)(nil)
+
+// FeeEstimate holds the fees of a transaction for each priority
+type FeeEstimate struct {
+	Size int // Serialized size of the transaction in bytes
+
+	// Fee per byte of each priority in Luna
+	LowFeePerByte    float64
+	NormalFeePerByte float64
+	HighFeePerByte   float64
+}
+
+// Fee returns the fee for the given priority, rounded up to whole Luna. An error is returned
+// if the fee per byte is invalid or the fee exceeds the total supply.
+func (e *FeeEstimate) Fee(priority Priority) (albatross.Luna, error) {
+	feePerByte := e.LowFeePerByte
+	switch priority {
+	case PriorityNormal:
+		feePerByte = e.NormalFeePerByte
+	case PriorityHigh:
+		feePerByte = e.HighFeePerByte
+	}
+	if err := validateFeePerByte(feePerByte); err != nil {
+		return 0, err
+	}
+
+	scaled := math.Round(feePerByte * feePerBytePrecision)
+	if scaled >= math.MaxUint64 {
+		return 0, fmt.Errorf("%w: fee per byte %v", albatross.ErrLunaOverflow, feePerByte)
+	}
+	return albatross.Luna(e.Size).MulDiv(uint64(scaled), feePerBytePrecision, albatross.RoundUp)
+}
+
+func validateFeePerByte(feePerByte float64) error {
+	if feePerByte < 0 || math.IsNaN(feePerByte) || math.IsInf(feePerByte, 0) {
+		return fmt.Errorf("invalid fee per byte %v", feePerByte)
+	}
+	return nil
+}
+
+// Size returns the serialized size of the transaction. Unsigned transactions are measured
+// as if they were signed by a single key.
+func (tx *Transaction) Size() (int, error) {
+	measured := *tx
+	if len(measured.Proof) == 0 {
+		measured.Proof = make([]byte, singleSignatureProofSize)
+	}
+
+	serialized, err := measured.Serialize()
+	if err != nil {
+		return 0, err
+	}
+	return len(serialized), nil
+}
+
+// EstimateFee estimates the fees of a transaction from the minimum fee per byte of the node and
+// the fee histogram of its mempool. If the mempool is empty all priorities pay the minimum fee.
+func EstimateFee(source FeeSource, tx *Transaction) (*FeeEstimate, error) {
+	minFeePerByte, err := source.GetMinFeePerByte()
+	if err != nil {
+		return nil, err
+	}
+	mempool, err := source.Mempool()
+	if err != nil {
+		return nil, err
+	}
+
+	estimate, err := EstimateFeeOffline(tx, minFeePerByte)
+	if err != nil {
+		return nil, err
+	}
+
+	total := 0
+	for _, count := range mempool.Histogram {
+		total += count
+	}
+	if total == 0 {
+		return estimate, nil
+	}
+
+	// The thresholds are walked from the best paying bucket down, the first non-empty bucket is
+	// the top of the mempool and the bucket reaching half of the transactions is its median
+	thresholds := mempool.Thresholds()
+	median, top := -1, -1
+	for i := len(thresholds) - 1; i >= 0; i-- {
+		threshold := thresholds[i]
+		if mempool.Histogram[threshold] == 0 {
+			continue
+		}
+		if top < 0 {
+			top = threshold
+		}
+		if median < 0 && 2*mempool.CountAtOrAbove(threshold) >= total {
+			median = threshold
+		}
+	}
+
+	estimate.NormalFeePerByte = math.Max(minFeePerByte, float64(median))
+	estimate.HighFeePerByte = math.Max(estimate.NormalFeePerByte, float64(top))
+	return estimate, nil
+}
+
+// EstimateFeeOffline estimates the fees of a transaction from a supplied fee per byte, e.g. a
+// cached result of GetMinFeePerByte. Without mempool data all priorities pay the same fee.
+func EstimateFeeOffline(tx *Transaction, feePerByte float64) (*FeeEstimate, error) {
+	if err := validateFeePerByte(feePerByte); err != nil {
+		return nil, err
+	}
+
+	size, err := tx.Size()
+	if err != nil {
+		return nil, err
+	}
+
+	return &FeeEstimate{
+		Size:             size,
+		LowFeePerByte:    feePerByte,
+		NormalFeePerByte: feePerByte,
+		HighFeePerByte:   feePerByte,
+	}, nil
+}
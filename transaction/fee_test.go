@@ -0,0 +1,114 @@
+package transaction
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/redmaner/albatross-go"
+	"github.com/stretchr/testify/assert"
+)
+
+type testFeeSource struct {
+	minFeePerByte float64
+	mempool       *albatross.MempoolInfo
+	err           error
+}
+
+func (s *testFeeSource) GetMinFeePerByte() (float64, error) {
+	return s.minFeePerByte, s.err
+}
+
+func (s *testFeeSource) Mempool() (*albatross.MempoolInfo, error) {
+	return s.mempool, s.err
+}
+
+func TestTransactionSize(t *testing.T) {
+	tx := NewBasicTransaction(albatross.Address{}, albatross.Address{}, 1, 0, 1, NetworkTestAlbatross)
+	size, err := tx.Size()
+	assert.NoError(t, err)
+	assert.Equal(t, len(testBasicHex)/2, size, "Unsigned transactions are measured as signed")
+
+	tx.Data = []byte("hello")
+	size, err = tx.Size()
+	assert.NoError(t, err)
+	assert.Equal(t, 174, size)
+
+	parsed, _ := ParseHex(testExtendedHex)
+	size, err = parsed.Size()
+	assert.NoError(t, err)
+	assert.Equal(t, len(testExtendedHex)/2, size)
+}
+
+func testFee(t *testing.T, estimate *FeeEstimate, priority Priority) albatross.Luna {
+	t.Helper()
+	fee, err := estimate.Fee(priority)
+	assert.NoError(t, err)
+	return fee
+}
+
+func TestEstimateFee(t *testing.T) {
+	tx := NewBasicTransaction(albatross.Address{}, albatross.Address{}, 1, 0, 1, NetworkTestAlbatross)
+	source := &testFeeSource{
+		minFeePerByte: 1,
+		mempool: &albatross.MempoolInfo{
+			Total:     10,
+			Histogram: map[int]int{0: 2, 1: 3, 2: 4, 5: 1},
+		},
+	}
+
+	estimate, err := EstimateFee(source, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, 138, estimate.Size)
+	assert.Equal(t, albatross.Luna(138), testFee(t, estimate, PriorityLow))
+	assert.Equal(t, albatross.Luna(276), testFee(t, estimate, PriorityNormal))
+	assert.Equal(t, albatross.Luna(690), testFee(t, estimate, PriorityHigh))
+}
+
+func TestEstimateFeeEmptyMempool(t *testing.T) {
+	tx := NewBasicTransaction(albatross.Address{}, albatross.Address{}, 1, 0, 1, NetworkTestAlbatross)
+	source := &testFeeSource{minFeePerByte: 0.5, mempool: &albatross.MempoolInfo{Histogram: map[int]int{}}}
+
+	estimate, err := EstimateFee(source, tx)
+	assert.NoError(t, err)
+	assert.Equal(t, albatross.Luna(69), testFee(t, estimate, PriorityLow))
+	assert.Equal(t, albatross.Luna(69), testFee(t, estimate, PriorityHigh))
+
+	source.err = errors.New("node unavailable")
+	_, err = EstimateFee(source, tx)
+	assert.Error(t, err)
+}
+
+func TestEstimateFeeOffline(t *testing.T) {
+	tx := NewBasicTransaction(albatross.Address{}, albatross.Address{}, 1, 0, 1, NetworkTestAlbatross)
+
+	estimate, err := EstimateFeeOffline(tx, 1.5)
+	assert.NoError(t, err)
+	assert.Equal(t, albatross.Luna(207), testFee(t, estimate, PriorityNormal))
+
+	for _, invalid := range []float64{-1, math.NaN(), math.Inf(1)} {
+		_, err := EstimateFeeOffline(tx, invalid)
+		assert.Error(t, err)
+	}
+}
+
+func TestFeeOverflow(t *testing.T) {
+	estimate := &FeeEstimate{Size: 138, LowFeePerByte: 0.1, NormalFeePerByte: 1e13, HighFeePerByte: 1e30}
+
+	assert.Equal(t, albatross.Luna(14), testFee(t, estimate, PriorityLow), "Fees are rounded up")
+	assert.Equal(t, albatross.Luna(138e13), testFee(t, estimate, PriorityNormal))
+
+	_, err := estimate.Fee(PriorityHigh)
+	assert.True(t, errors.Is(err, albatross.ErrLunaOverflow))
+
+	estimate.Size = 1000000
+	_, err = estimate.Fee(PriorityNormal)
+	assert.True(t, errors.Is(err, albatross.ErrExceedsSupply))
+
+	estimate.LowFeePerByte = math.NaN()
+	_, err = estimate.Fee(PriorityLow)
+	assert.Error(t, err)
+}
@@ -0,0 +1,157 @@
+package transaction
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/redmaner/albatross-go"
+	"github.com/redmaner/albatross-go/keys"
+	"golang.org/x/crypto/blake2b"
+)
+
+// ErrInvalidProof is returned when a signature proof cannot be decoded
+var ErrInvalidProof = errors.New("invalid signature proof")
+
+// SignatureAlgorithm is the algorithm of the key that signed a proof
+type SignatureAlgorithm uint8
+
+const (
+	AlgorithmEd25519 SignatureAlgorithm = 0
+	AlgorithmES256   SignatureAlgorithm = 1 // WebAuthn keys, not supported by this package
+)
+
+// proofFlagWebauthnFields marks proofs that carry extra WebAuthn fields
+const proofFlagWebauthnFields = 1 << 0
+
+// MerklePathNode is a sibling hash on the path from a public key to the root of a multisig tree
+type MerklePathNode struct {
+	Left bool // Whether the sibling is the left child
+	Hash [32]byte
+}
+
+// SignatureProof proves that a transaction was signed by the owner of an Ed25519 public key.
+// For single signature accounts the merkle path is empty.
+type SignatureProof struct {
+	PublicKey  keys.PublicKey
+	MerklePath []MerklePathNode
+	Signature  [keys.SignatureLength]byte
+}
+
+// NewSignatureProof returns the proof of a signature by a single key
+func NewSignatureProof(publicKey keys.PublicKey, signature []byte) (*SignatureProof, error) {
+	if len(signature) != keys.SignatureLength {
+		return nil, fmt.Errorf("%w: expected a signature of %d bytes, got %d", ErrInvalidProof, keys.SignatureLength, len(signature))
+	}
+
+	proof := &SignatureProof{PublicKey: publicKey}
+	copy(proof.Signature[:], signature)
+	return proof, nil
+}
+
+// Serialize returns the binary serialization of the proof:
+// algorithm and flags (u8) | public key | merkle path length (u8) | left bits | hashes | signature.
+// The algorithm is stored in the upper four bits and the flags in the lower four bits.
+func (p *SignatureProof) Serialize() []byte {
+	var b bytes.Buffer
+	b.WriteByte(byte(AlgorithmEd25519) << 4)
+	b.Write(p.PublicKey[:])
+
+	b.WriteByte(byte(len(p.MerklePath)))
+	leftBits := make([]byte, (len(p.MerklePath)+7)/8)
+	for i, node := range p.MerklePath {
+		if node.Left {
+			leftBits[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+	b.Write(leftBits)
+	for _, node := range p.MerklePath {
+		b.Write(node.Hash[:])
+	}
+
+	b.Write(p.Signature[:])
+	return b.Bytes()
+}
+
+// ParseSignatureProof decodes a serialized signature proof
+func ParseSignatureProof(data []byte) (*SignatureProof, error) {
+	r := bytes.NewReader(data)
+	proof := &SignatureProof{}
+
+	typeAndFlags, err := r.ReadByte()
+	if err != nil {
+		return nil, fmt.Errorf("%w: reading algorithm: %s", ErrInvalidProof, err)
+	}
+	if algorithm := SignatureAlgorithm(typeAndFlags >> 4); algorithm != AlgorithmEd25519 {
+		return nil, fmt.Errorf("%w: unsupported signature algorithm %d", ErrInvalidProof, algorithm)
+	}
+	if typeAndFlags&proofFlagWebauthnFields != 0 {
+		return nil, fmt.Errorf("%w: WebAuthn proofs are not supported", ErrInvalidProof)
+	}
+	if typeAndFlags&0x0f != 0 {
+		return nil, fmt.Errorf("%w: unknown flags %#x", ErrInvalidProof, typeAndFlags&0x0f)
+	}
+
+	if _, err := io.ReadFull(r, proof.PublicKey[:]); err != nil {
+		return nil, fmt.Errorf("%w: reading public key: %s", ErrInvalidProof, err)
+	}
+
+	var pathLength uint8
+	if err := binary.Read(r, binary.BigEndian, &pathLength); err != nil {
+		return nil, fmt.Errorf("%w: reading merkle path: %s", ErrInvalidProof, err)
+	}
+	leftBits := make([]byte, (int(pathLength)+7)/8)
+	if _, err := io.ReadFull(r, leftBits); err != nil {
+		return nil, fmt.Errorf("%w: reading merkle path: %s", ErrInvalidProof, err)
+	}
+	for i := 0; i < int(pathLength); i++ {
+		node := MerklePathNode{Left: leftBits[i/8]&(0x80>>(i%8)) != 0}
+		if _, err := io.ReadFull(r, node.Hash[:]); err != nil {
+			return nil, fmt.Errorf("%w: reading merkle path: %s", ErrInvalidProof, err)
+		}
+		proof.MerklePath = append(proof.MerklePath, node)
+	}
+
+	if _, err := io.ReadFull(r, proof.Signature[:]); err != nil {
+		return nil, fmt.Errorf("%w: reading signature: %s", ErrInvalidProof, err)
+	}
+	if r.Len() > 0 {
+		return nil, fmt.Errorf("%w: %d trailing bytes", ErrInvalidProof, r.Len())
+	}
+
+	return proof, nil
+}
+
+// IsSingleSignature returns whether the proof is signed by a single key without merkle path
+func (p *SignatureProof) IsSingleSignature() bool {
+	return len(p.MerklePath) == 0
+}
+
+// Signer returns the address of the account the proof signs for. That is the root of the merkle
+// path, starting from the hash of the public key, so for single signature proofs it is the
+// address of the public key and for multisig proofs the address of the multisig account.
+func (p *SignatureProof) Signer() albatross.Address {
+	root := blake2b.Sum256(p.PublicKey[:])
+	for _, node := range p.MerklePath {
+		if node.Left {
+			root = hashPair(node.Hash, root)
+		} else {
+			root = hashPair(root, node.Hash)
+		}
+	}
+
+	var address albatross.Address
+	copy(address[:], root[:albatross.AddressLength])
+	return address
+}
+
+func hashPair(left, right [32]byte) [32]byte {
+	return blake2b.Sum256(append(left[:], right[:]...))
+}
+
+// Verify returns whether the signature of the proof is valid for message
+func (p *SignatureProof) Verify(message []byte) bool {
+	return p.PublicKey.Verify(message, p.Signature[:])
+}
@@ -0,0 +1,56 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/blake2b"
+)
+
+func TestMerklePathProof(t *testing.T) {
+	proof := &SignatureProof{
+		PublicKey:  testKey(t).PublicKey,
+		MerklePath: []MerklePathNode{{Left: true, Hash: [32]byte{1}}, {Left: false, Hash: [32]byte{2}}, {Left: true, Hash: [32]byte{3}}},
+	}
+
+	serialized := proof.Serialize()
+	assert.Equal(t, "03a0", hex.EncodeToString(serialized[33:35]), "Merkle path length and left bits")
+
+	parsed, err := ParseSignatureProof(serialized)
+	assert.NoError(t, err)
+	assert.Equal(t, proof, parsed)
+	assert.False(t, parsed.IsSingleSignature())
+
+	_, err = ParseSignatureProof(serialized[:len(serialized)-1])
+	assert.True(t, errors.Is(err, ErrInvalidProof))
+	_, err = ParseSignatureProof(append(serialized, 0))
+	assert.True(t, errors.Is(err, ErrInvalidProof))
+}
+
+func TestUnsupportedProofAlgorithm(t *testing.T) {
+	serialized := (&SignatureProof{PublicKey: testKey(t).PublicKey}).Serialize()
+
+	for _, typeAndFlags := range []byte{0x10, 0x01, 0x11} {
+		serialized[0] = typeAndFlags
+		_, err := ParseSignatureProof(serialized)
+		assert.True(t, errors.Is(err, ErrInvalidProof), "Algorithm and flags %#x should be rejected", typeAndFlags)
+	}
+}
+
+func TestSignatureProofSigner(t *testing.T) {
+	proof := &SignatureProof{PublicKey: testKey(t).PublicKey}
+	assert.Equal(t, testKey(t).Address(), proof.Signer(), "Single signature proofs sign for the key")
+
+	// Root of a tree of two keys, the sibling is the hash of the other key
+	sibling := [32]byte{7}
+	proof.MerklePath = []MerklePathNode{{Left: false, Hash: sibling}}
+	leaf := blake2b.Sum256(proof.PublicKey[:])
+	root := blake2b.Sum256(append(leaf[:], sibling[:]...))
+	assert.Equal(t, hex.EncodeToString(root[:20]), proof.Signer().Hex())
+
+	proof.MerklePath[0].Left = true
+	root = blake2b.Sum256(append(sibling[:], leaf[:]...))
+	assert.Equal(t, hex.EncodeToString(root[:20]), proof.Signer().Hex())
+}
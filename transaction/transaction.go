@@ -0,0 +1,244 @@
+// Package transaction builds, serializes and signs Albatross transactions offline,
+// so they can be sent with SendRawTransaction without an unlocked account on the node.
+package transaction
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/redmaner/albatross-go"
+	"github.com/redmaner/albatross-go/keys"
+	"golang.org/x/crypto/blake2b"
+)
+
+// AccountType is the type of the sender or recipient account of a transaction
+type AccountType uint8
+
+const (
+	AccountTypeBasic   AccountType = 0
+	AccountTypeVesting AccountType = 1
+	AccountTypeHTLC    AccountType = 2
+	AccountTypeStaking AccountType = 3
+)
+
+// NetworkID identifies the network a transaction is valid on
+type NetworkID uint8
+
+const (
+	NetworkTestAlbatross NetworkID = 5
+	NetworkDevAlbatross  NetworkID = 6
+	NetworkUnitAlbatross NetworkID = 7
+	NetworkMainAlbatross NetworkID = 24
+)
+
+// Flags are the flags of an extended transaction
+type Flags uint8
+
+const (
+	FlagContractCreation Flags = 1 << 0 // The transaction creates the recipient contract
+	FlagSignaling        Flags = 1 << 1 // The transaction only signals to the staking contract
+)
+
+// Format is the serialization format of a transaction
+type Format uint8
+
+const (
+	FormatBasic    Format = 0 // Basic transfer between basic accounts without data
+	FormatExtended Format = 1 // Any other transaction
+)
+
+// ErrInvalidTransaction is returned when a transaction cannot be serialized or signed
+var ErrInvalidTransaction = errors.New("invalid transaction")
+
+// Transaction is an Albatross transaction in its binary form
+type Transaction struct {
+	Sender        albatross.Address
+	SenderType    AccountType
+	Recipient     albatross.Address
+	RecipientType AccountType
+
+	Value               albatross.Luna
+	Fee                 albatross.Luna
+	ValidityStartHeight uint32
+	NetworkID           NetworkID
+	Flags               Flags
+
+	SenderData []byte // Data for the sender, e.g. the parameters of an outgoing staking transaction
+	Data       []byte // Data for the recipient, e.g. a message or the parameters of a contract
+	Proof      []byte // Serialized proof, set by Sign
+}
+
+// NewBasicTransaction returns a transfer of value from one basic account to another
+func NewBasicTransaction(sender, recipient albatross.Address, value, fee albatross.Luna, validityStartHeight uint32, network NetworkID) *Transaction {
+	return &Transaction{
+		Sender:              sender,
+		SenderType:          AccountTypeBasic,
+		Recipient:           recipient,
+		RecipientType:       AccountTypeBasic,
+		Value:               value,
+		Fee:                 fee,
+		ValidityStartHeight: validityStartHeight,
+		NetworkID:           network,
+	}
+}
+
+// Format returns the format the transaction is serialized in
+func (tx *Transaction) Format() Format {
+	if tx.SenderType != AccountTypeBasic || tx.RecipientType != AccountTypeBasic ||
+		len(tx.SenderData) > 0 || len(tx.Data) > 0 || tx.Flags != 0 {
+		return FormatExtended
+	}
+
+	// Only single signature proofs fit the basic format
+	if len(tx.Proof) > 0 {
+		proof, err := ParseSignatureProof(tx.Proof)
+		if err != nil || !proof.IsSingleSignature() {
+			return FormatExtended
+		}
+	}
+
+	return FormatBasic
+}
+
+func (tx *Transaction) validate() error {
+	if len(tx.SenderData) > math.MaxUint16 {
+		return fmt.Errorf("%w: sender data exceeds %d bytes", ErrInvalidTransaction, math.MaxUint16)
+	}
+	if len(tx.Data) > math.MaxUint16 {
+		return fmt.Errorf("%w: data exceeds %d bytes", ErrInvalidTransaction, math.MaxUint16)
+	}
+	if len(tx.Proof) > math.MaxUint16 {
+		return fmt.Errorf("%w: proof exceeds %d bytes", ErrInvalidTransaction, math.MaxUint16)
+	}
+	if _, err := tx.Value.Add(tx.Fee); err != nil {
+		return fmt.Errorf("%w: value and fee: %s", ErrInvalidTransaction, err)
+	}
+	return nil
+}
+
+// SerializeContent returns the part of the transaction that is signed:
+// data | sender | sender type | recipient | recipient type | value | fee |
+// validity start height | network id | flags | sender data
+func (tx *Transaction) SerializeContent() ([]byte, error) {
+	if err := tx.validate(); err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	writeWithLength(&b, tx.Data)
+	b.Write(tx.Sender[:])
+	b.WriteByte(byte(tx.SenderType))
+	b.Write(tx.Recipient[:])
+	b.WriteByte(byte(tx.RecipientType))
+	binary.Write(&b, binary.BigEndian, uint64(tx.Value))
+	binary.Write(&b, binary.BigEndian, uint64(tx.Fee))
+	binary.Write(&b, binary.BigEndian, tx.ValidityStartHeight)
+	b.WriteByte(byte(tx.NetworkID))
+	b.WriteByte(byte(tx.Flags))
+	writeWithLength(&b, tx.SenderData)
+	return b.Bytes(), nil
+}
+
+// Hash returns the hash of the transaction, which is the Blake2b hash of its content
+func (tx *Transaction) Hash() (albatross.Hash, error) {
+	content, err := tx.SerializeContent()
+	if err != nil {
+		return albatross.Hash{}, err
+	}
+	return blake2b.Sum256(content), nil
+}
+
+// Sign signs the transaction with the given key and sets its proof. If the sender is not set
+// it becomes the address of the key. For basic senders the key must match the sender.
+// HTLC senders need an HTLC proof instead of a signature proof and cannot be signed with Sign.
+func (tx *Transaction) Sign(key *keys.KeyPair) error {
+	if tx.SenderType == AccountTypeHTLC {
+		return fmt.Errorf("%w: HTLC senders require an HTLC proof", ErrInvalidTransaction)
+	}
+	if tx.Sender.IsZero() {
+		tx.Sender = key.Address()
+	}
+	if tx.SenderType == AccountTypeBasic && tx.Sender != key.Address() {
+		return fmt.Errorf("%w: key of %s cannot sign for sender %s", ErrInvalidTransaction, key.Address(), tx.Sender)
+	}
+
+	content, err := tx.SerializeContent()
+	if err != nil {
+		return err
+	}
+
+	proof, err := NewSignatureProof(key.PublicKey, key.Sign(content))
+	if err != nil {
+		return err
+	}
+	tx.Proof = proof.Serialize()
+	return nil
+}
+
+// Serialize returns the binary serialization of a signed transaction. Basic transactions are
+// serialized in the compact basic format, which omits the sender and contains only the public key
+// and signature of the proof. The extended format is:
+// sender | sender type | sender data | recipient | recipient type | data | value | fee |
+// validity start height | network id | flags | proof
+func (tx *Transaction) Serialize() ([]byte, error) {
+	if err := tx.validate(); err != nil {
+		return nil, err
+	}
+	if len(tx.Proof) == 0 {
+		return nil, fmt.Errorf("%w: transaction is not signed", ErrInvalidTransaction)
+	}
+
+	var b bytes.Buffer
+	format := tx.Format()
+	b.WriteByte(byte(format))
+
+	if format == FormatBasic {
+		proof, err := ParseSignatureProof(tx.Proof)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(proof.PublicKey[:])
+		b.Write(tx.Recipient[:])
+		binary.Write(&b, binary.BigEndian, uint64(tx.Value))
+		binary.Write(&b, binary.BigEndian, uint64(tx.Fee))
+		binary.Write(&b, binary.BigEndian, tx.ValidityStartHeight)
+		b.WriteByte(byte(tx.NetworkID))
+		b.Write(proof.Signature[:])
+		return b.Bytes(), nil
+	}
+
+	b.Write(tx.Sender[:])
+	b.WriteByte(byte(tx.SenderType))
+	writeWithLength(&b, tx.SenderData)
+	b.Write(tx.Recipient[:])
+	b.WriteByte(byte(tx.RecipientType))
+	writeWithLength(&b, tx.Data)
+	binary.Write(&b, binary.BigEndian, uint64(tx.Value))
+	binary.Write(&b, binary.BigEndian, uint64(tx.Fee))
+	binary.Write(&b, binary.BigEndian, tx.ValidityStartHeight)
+	b.WriteByte(byte(tx.NetworkID))
+	b.WriteByte(byte(tx.Flags))
+	writeWithLength(&b, tx.Proof)
+	return b.Bytes(), nil
+}
+
+// Hex returns the hex encoded serialization of a signed transaction, as accepted by
+// SendRawTransaction and PushTransaction
+func (tx *Transaction) Hex() (string, error) {
+	b, err := tx.Serialize()
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// writeWithLength writes data prefixed with its length as big endian u16. The width of the
+// prefix has not been verified against transactions of a node yet.
+func writeWithLength(b *bytes.Buffer, data []byte) {
+	binary.Write(b, binary.BigEndian, uint16(len(data)))
+	b.Write(data)
+}
@@ -0,0 +1,149 @@
+package transaction
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/redmaner/albatross-go"
+	"github.com/redmaner/albatross-go/keys"
+	"github.com/stretchr/testify/assert"
+)
+
+// Sources of the vectors:
+//   - testPrivateKey and the public key in both transactions are the first test vector of
+//     RFC 8032, section 7.1.
+//   - testBasicHex, testBasicHash, testExtendedHex and testExtendedHash were produced by this
+//     package and reproduced with a separate Python implementation of the same layout (RFC 8032
+//     Ed25519 and hashlib Blake2b). Both implement the layout as understood from core-rs-albatross,
+//     so the vectors only show the two agree, not that a node accepts the transactions.
+//
+// None of the transaction vectors comes from core-rs-albatross or a node. In particular the u16
+// big endian length prefixes of data, sender data and proof are unverified, and so are the
+// signed content and the hashes that include them. The vectors must be replaced with
+// transactions created by a node or taken from the core-rs-albatross tests, noting their source.
+const (
+	testPrivateKey = "9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60"
+
+	testBasicHex = "00" + // format
+		"d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a" + // public key
+		"d44b295c41dd43cf041d88718320357fd346e8cc" + // recipient
+		"00000000000186a0" + // value
+		"000000000000008a" + // fee
+		"000003e8" + // validity start height
+		"05" + // network id
+		"21f99db8a1a834baa3c90b93beebd308be36359243bc1bde6fc0c152e41eea37" + // signature
+		"a6561e959ed888ea8dcfd384378e8cef46a174ee5ad1d3678fa1edafaffc1a00"
+	testBasicHash = "c5fe97eb30953c08b42bd26abea42ff70381edb30e251e12ebacc0d5871cb883"
+
+	testExtendedHex = "01" + // format
+		"7849ac3049680be1ef762efe0d36e01733c3464e" + "00" + "00020405" + // sender, type and data
+		"d6d530da000000000000602dbca99b0000000000" + "03" + "0003010203" + // recipient, type and data
+		"0000000000000000" + // value
+		"00000000000001f4" + // fee
+		"000003e8" + // validity start height
+		"05" + // network id
+		"02" + // flags
+		"0062" + // proof length
+		"00" + // algorithm and flags
+		"d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a" + "00" + // public key and empty merkle path
+		"2ee9c7db722136f1a40b5626ebd91ab350b9bd910d7c60df31a056def701e799" + // signature
+		"6ecf1b0e728ec3891dbae9f92e828f7eeaa4a2d8ac2b72725496b8025f231c04"
+	testExtendedHash = "52aaec0abb856f16042dac5aa142cc5651c597a42133cd3925731a2930f4176c"
+)
+
+func testKey(t *testing.T) *keys.KeyPair {
+	t.Helper()
+	private, err := keys.ParsePrivateKey(testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return keys.FromPrivateKey(private)
+}
+
+func testAddress(t *testing.T, s string) albatross.Address {
+	t.Helper()
+	a, err := albatross.ParseAddress(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func TestSignBasicTransaction(t *testing.T) {
+	recipient := testAddress(t, "NQ61 SH5J JP21 TM1U X10V H1QQ 681M FY9L DS6C")
+	tx := NewBasicTransaction(albatross.Address{}, recipient, 100000, 138, 1000, NetworkTestAlbatross)
+
+	assert.NoError(t, tx.Sign(testKey(t)))
+	assert.Equal(t, testKey(t).Address(), tx.Sender)
+	assert.Equal(t, FormatBasic, tx.Format())
+
+	raw, err := tx.Hex()
+	assert.NoError(t, err)
+	assert.Equal(t, testBasicHex, raw)
+
+	hash, err := tx.Hash()
+	assert.NoError(t, err)
+	assert.Equal(t, testBasicHash, hash.String())
+}
+
+func TestSignExtendedTransaction(t *testing.T) {
+	tx := &Transaction{
+		SenderType:          AccountTypeBasic,
+		Recipient:           testAddress(t, "NQ38 STAK 1NG0 0000 0000 C0NT RACT 0000 0000"),
+		RecipientType:       AccountTypeStaking,
+		Fee:                 500,
+		ValidityStartHeight: 1000,
+		NetworkID:           NetworkTestAlbatross,
+		Flags:               FlagSignaling,
+		SenderData:          []byte{4, 5},
+		Data:                []byte{1, 2, 3},
+	}
+
+	assert.NoError(t, tx.Sign(testKey(t)))
+	assert.Equal(t, FormatExtended, tx.Format())
+
+	raw, err := tx.Hex()
+	assert.NoError(t, err)
+	assert.Equal(t, testExtendedHex, raw)
+
+	hash, err := tx.Hash()
+	assert.NoError(t, err)
+	assert.Equal(t, testExtendedHash, hash.String())
+
+	proof, err := ParseSignatureProof(tx.Proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, _ := tx.SerializeContent()
+	assert.True(t, proof.Verify(content))
+}
+
+func TestSignWithWrongKey(t *testing.T) {
+	sender := testAddress(t, "NQ61 SH5J JP21 TM1U X10V H1QQ 681M FY9L DS6C")
+	tx := NewBasicTransaction(sender, sender, 1, 0, 1, NetworkTestAlbatross)
+
+	err := tx.Sign(testKey(t))
+	assert.True(t, errors.Is(err, ErrInvalidTransaction))
+
+	// Contracts are signed by their owner, whose address differs from the contract
+	tx.SenderType = AccountTypeVesting
+	assert.NoError(t, tx.Sign(testKey(t)))
+
+	tx.SenderType = AccountTypeHTLC
+	err = tx.Sign(testKey(t))
+	assert.True(t, errors.Is(err, ErrInvalidTransaction), "HTLC senders need an HTLC proof")
+}
+
+func TestSerializeInvalidTransaction(t *testing.T) {
+	tx := NewBasicTransaction(albatross.Address{}, albatross.Address{}, 1, 0, 1, NetworkTestAlbatross)
+	_, err := tx.Serialize()
+	assert.True(t, errors.Is(err, ErrInvalidTransaction), "Unsigned transactions cannot be serialized")
+
+	tx.Value = albatross.MaxLuna
+	tx.Fee = 1
+	assert.Error(t, tx.Sign(testKey(t)))
+
+	tx.Fee = 0
+	tx.Data = make([]byte, 1<<16)
+	assert.Error(t, tx.Sign(testKey(t)))
+}
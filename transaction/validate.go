@@ -0,0 +1,224 @@
+package transaction
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/redmaner/albatross-go"
+)
+
+var (
+	// ErrZeroValue is returned for transactions without value that are not signaling transactions
+	ErrZeroValue = errors.New("value must be greater than zero")
+	// ErrInsufficientBalance is returned when the sender cannot pay value and fee
+	ErrInsufficientBalance = errors.New("insufficient balance")
+	// ErrFeeTooLow is returned when the fee is below the minimum fee of the node
+	ErrFeeTooLow = errors.New("fee below minimum")
+	// ErrSenderTypeMismatch is returned when the sender type does not match the sender account
+	ErrSenderTypeMismatch = errors.New("sender type does not match account")
+	// ErrUnknownNetwork is returned for network ids of no known network
+	ErrUnknownNetwork = errors.New("unknown network")
+)
+
+// FieldError is the validation error of a single field of a transaction
+type FieldError struct {
+	Field string // Name of the field of Transaction
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Err)
+}
+
+// Unwrap returns the cause of the error, so it can be checked with errors.Is
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationError holds all field errors found by Validate
+type ValidationError struct {
+	Fields []*FieldError
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		messages[i] = field.Error()
+	}
+	return "invalid transaction: " + strings.Join(messages, "; ")
+}
+
+// Field returns the first error of the given field, or nil if the field is valid
+func (e *ValidationError) Field(name string) *FieldError {
+	for _, field := range e.Fields {
+		if field.Field == name {
+			return field
+		}
+	}
+	return nil
+}
+
+// Is reports whether any of the field errors matches target
+func (e *ValidationError) Is(target error) bool {
+	for _, field := range e.Fields {
+		if errors.Is(field.Err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *ValidationError) add(field string, err error) {
+	e.Fields = append(e.Fields, &FieldError{Field: field, Err: err})
+}
+
+// ValidationContext holds the chain state a transaction is validated against. Checks that need
+// state which is not set are skipped.
+type ValidationContext struct {
+	Sender        albatross.Account // Account of the sender, as returned by GetAccountByAddress
+	MinFeePerByte float64           // As returned by GetMinFeePerByte
+	Policy        *albatross.Policy // Policy for the validity window, as returned by GetPolicyConstants
+	Head          int               // Number of the head block, required with Policy
+	Time          time.Time         // Timestamp of the head block for vesting contracts, defaults to now
+}
+
+// ValidationSource provides the chain state to validate transactions, it is implemented by HttpClient
+type ValidationSource interface {
+	GetAccountByAddress(address albatross.Address) (albatross.Account, error)
+	GetMinFeePerByte() (float64, error)
+	GetPolicyConstants() (*albatross.Policy, error)
+	GetLatestBlock(includeFullTransactions ...bool) (albatross.Block, error)
+}
+
+var _ ValidationSource = (*albatross.HttpClient)(nil)
+
+var accountTypes = map[AccountType]albatross.AccountType{
+	AccountTypeBasic:   albatross.AccountTypeBasic,
+	AccountTypeVesting: albatross.AccountTypeVesting,
+	AccountTypeHTLC:    albatross.AccountTypeHtlc,
+	AccountTypeStaking: albatross.AccountTypeStaking,
+}
+
+// Validate checks the transaction before it is broadcast and returns a *ValidationError listing
+// every invalid field, or nil if the transaction is valid. Addresses are stored as raw bytes,
+// their checksums are validated when they are parsed with albatross.ParseAddress. The burn address
+// is a valid recipient but not a valid sender, the same rule the RPC wrappers apply.
+func (tx *Transaction) Validate(ctx *ValidationContext) error {
+	if ctx == nil {
+		ctx = &ValidationContext{}
+	}
+	errs := &ValidationError{}
+
+	if tx.Value == 0 && tx.Flags&FlagSignaling == 0 {
+		errs.add("Value", ErrZeroValue)
+	}
+	total, err := tx.Value.Add(tx.Fee)
+	if err != nil {
+		errs.add("Value", err)
+	}
+
+	if tx.Sender == (albatross.Address{}) {
+		errs.add("Sender", fmt.Errorf("%w: nobody holds the key of the burn address", albatross.ErrInvalidAddress))
+	}
+	if tx.Recipient == tx.Sender {
+		errs.add("Recipient", fmt.Errorf("%w: sender and recipient are equal", albatross.ErrInvalidAddress))
+	}
+	if _, ok := accountTypes[tx.RecipientType]; !ok {
+		errs.add("RecipientType", fmt.Errorf("unknown account type %d", tx.RecipientType))
+	}
+
+	switch tx.NetworkID {
+	case NetworkMainAlbatross, NetworkTestAlbatross, NetworkDevAlbatross, NetworkUnitAlbatross:
+	default:
+		errs.add("NetworkID", fmt.Errorf("%w: %s", ErrUnknownNetwork, tx.NetworkID))
+	}
+
+	if len(tx.SenderData) > math.MaxUint16 {
+		errs.add("SenderData", fmt.Errorf("sender data of %d bytes exceeds %d bytes", len(tx.SenderData), math.MaxUint16))
+	}
+	if len(tx.Data) > math.MaxUint16 {
+		errs.add("Data", fmt.Errorf("data of %d bytes exceeds %d bytes", len(tx.Data), math.MaxUint16))
+	}
+	if len(tx.Proof) > math.MaxUint16 {
+		errs.add("Proof", fmt.Errorf("proof of %d bytes exceeds %d bytes", len(tx.Proof), math.MaxUint16))
+	}
+	if len(tx.SenderData) <= math.MaxUint16 && len(tx.Data) <= math.MaxUint16 && len(tx.Proof) <= math.MaxUint16 && err == nil {
+		if estimate, feeErr := EstimateFeeOffline(tx, ctx.MinFeePerByte); feeErr != nil {
+			errs.add("Fee", feeErr)
+		} else if minFee, feeErr := estimate.Fee(PriorityLow); feeErr != nil {
+			errs.add("Fee", feeErr)
+		} else if tx.Fee < minFee {
+			errs.add("Fee", fmt.Errorf("%w: %d luna for %d bytes, the minimum is %d luna", ErrFeeTooLow, tx.Fee, estimate.Size, minFee))
+		}
+	}
+
+	if ctx.Sender != nil {
+		if ctx.Sender.Base().Address != tx.Sender {
+			errs.add("Sender", fmt.Errorf("account of %s given for sender %s", ctx.Sender.Base().Address, tx.Sender))
+		}
+		if accountTypes[tx.SenderType] != ctx.Sender.Type() {
+			errs.add("SenderType", fmt.Errorf("%w: sender is a %s account", ErrSenderTypeMismatch, ctx.Sender.Type()))
+		}
+		if balance := spendable(ctx.Sender, ctx.Time); err == nil && balance < total {
+			errs.add("Value", fmt.Errorf("%w: value and fee of %d luna exceed spendable balance of %d luna", ErrInsufficientBalance, total, balance))
+		}
+	}
+
+	if ctx.Policy != nil {
+		if err := ctx.Policy.ValidateValidityStartHeight(int(tx.ValidityStartHeight), ctx.Head); err != nil {
+			errs.add("ValidityStartHeight", err)
+		}
+	}
+
+	if len(errs.Fields) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// spendable returns the part of the balance of the sender that can be sent at the given time.
+// Vesting contracts only release the vested amount. HTLCs release their whole balance, before the
+// timeout to the recipient or both parties and after it to the sender. Which of them signed is
+// part of the HTLC proof, which is checked by the node.
+func spendable(sender albatross.Account, at time.Time) albatross.Luna {
+	if vesting, ok := sender.(*albatross.VestingAccount); ok {
+		if at.IsZero() {
+			at = time.Now()
+		}
+		return vesting.Spendable(at)
+	}
+	return sender.Base().Balance
+}
+
+// ValidateWithNode retrieves the sender account, minimum fee, policy and head block from the node
+// and validates the transaction against them. Vesting contracts are evaluated at the timestamp of
+// the head block. Errors of the node are returned as is.
+func ValidateWithNode(source ValidationSource, tx *Transaction) error {
+	sender, err := source.GetAccountByAddress(tx.Sender)
+	if err != nil {
+		return err
+	}
+	minFeePerByte, err := source.GetMinFeePerByte()
+	if err != nil {
+		return err
+	}
+	policy, err := source.GetPolicyConstants()
+	if err != nil {
+		return err
+	}
+	head, err := source.GetLatestBlock()
+	if err != nil {
+		return err
+	}
+
+	return tx.Validate(&ValidationContext{
+		Sender:        sender,
+		MinFeePerByte: minFeePerByte,
+		Policy:        policy,
+		Head:          head.Header().Number,
+		Time:          time.UnixMilli(head.Header().Timestamp),
+	})
+}
@@ -0,0 +1,185 @@
+package transaction
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/redmaner/albatross-go"
+	"github.com/stretchr/testify/assert"
+)
+
+type testValidationSource struct {
+	account   albatross.Account
+	timestamp int64 // Timestamp of the head block in milliseconds
+}
+
+func (s *testValidationSource) GetAccountByAddress(address albatross.Address) (albatross.Account, error) {
+	return s.account, nil
+}
+
+func (s *testValidationSource) GetMinFeePerByte() (float64, error) {
+	return 1, nil
+}
+
+func (s *testValidationSource) GetPolicyConstants() (*albatross.Policy, error) {
+	return &albatross.Policy{TransactionValidityWindow: 7200}, nil
+}
+
+func (s *testValidationSource) GetLatestBlock(includeFullTransactions ...bool) (albatross.Block, error) {
+	return &albatross.MicroBlock{BlockHeader: albatross.BlockHeader{Number: 1000, Timestamp: s.timestamp}}, nil
+}
+
+func testTransaction(t *testing.T) *Transaction {
+	sender := testKey(t).Address()
+	recipient := testAddress(t, "NQ61 SH5J JP21 TM1U X10V H1QQ 681M FY9L DS6C")
+	return NewBasicTransaction(sender, recipient, 100000, 138, 1000, NetworkTestAlbatross)
+}
+
+func testBasicAccount(t *testing.T, balance albatross.Luna) albatross.Account {
+	return &albatross.BasicAccount{AccountBase: albatross.AccountBase{Address: testKey(t).Address(), Balance: balance}}
+}
+
+func TestValidateTransaction(t *testing.T) {
+	tx := testTransaction(t)
+	ctx := &ValidationContext{
+		Sender:        testBasicAccount(t, 100138),
+		MinFeePerByte: 1,
+		Policy:        &albatross.Policy{TransactionValidityWindow: 7200},
+		Head:          1000,
+	}
+	assert.NoError(t, tx.Validate(ctx))
+	assert.NoError(t, tx.Validate(nil), "Checks without chain state are skipped")
+}
+
+func TestValidateFieldErrors(t *testing.T) {
+	tx := testTransaction(t)
+	tx.Value = 0
+	tx.Fee = 100
+	tx.Recipient = tx.Sender
+	tx.NetworkID = 42
+	tx.ValidityStartHeight = 100
+
+	err := tx.Validate(&ValidationContext{
+		Sender:        testBasicAccount(t, 50),
+		MinFeePerByte: 1,
+		Policy:        &albatross.Policy{TransactionValidityWindow: 24},
+		Head:          1000,
+	})
+
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Expected a validation error, got %v", err)
+	}
+
+	assert.True(t, errors.Is(validationErr.Field("Value"), ErrZeroValue))
+	assert.True(t, errors.Is(validationErr.Field("Recipient"), albatross.ErrInvalidAddress))
+	assert.True(t, errors.Is(validationErr.Field("NetworkID"), ErrUnknownNetwork))
+	assert.True(t, errors.Is(validationErr.Field("Fee"), ErrFeeTooLow))
+	assert.True(t, errors.Is(validationErr.Field("ValidityStartHeight"), albatross.ErrTransactionExpired))
+	assert.True(t, errors.Is(err, ErrInsufficientBalance))
+	assert.Nil(t, validationErr.Field("SenderType"))
+	assert.Contains(t, err.Error(), "Fee: fee below minimum")
+}
+
+func TestValidateMinimumFee(t *testing.T) {
+	tx := testTransaction(t)
+
+	// 138 bytes at 1.01 luna per byte are 139.38 luna, which is rounded up
+	tx.Fee = 139
+	err := tx.Validate(&ValidationContext{MinFeePerByte: 1.01})
+	assert.True(t, errors.Is(err, ErrFeeTooLow))
+
+	tx.Fee = 140
+	assert.NoError(t, tx.Validate(&ValidationContext{MinFeePerByte: 1.01}))
+
+	err = tx.Validate(&ValidationContext{MinFeePerByte: -1})
+	assert.NotNil(t, err.(*ValidationError).Field("Fee"), "Invalid fees per byte are reported")
+}
+
+func TestValidateBurnAddress(t *testing.T) {
+	tx := testTransaction(t)
+	tx.Recipient = albatross.Address{}
+	assert.NoError(t, tx.Validate(nil), "The burn address is a valid recipient")
+
+	tx = testTransaction(t)
+	tx.Sender = albatross.Address{}
+	err := tx.Validate(nil)
+	assert.True(t, errors.Is(err, albatross.ErrInvalidAddress))
+	assert.NotNil(t, err.(*ValidationError).Field("Sender"), "The burn address cannot send")
+}
+
+func TestValidateSenderAccount(t *testing.T) {
+	tx := testTransaction(t)
+
+	vesting := &albatross.VestingAccount{AccountBase: albatross.AccountBase{Address: tx.Sender, Balance: 1000000}}
+	err := tx.Validate(&ValidationContext{Sender: vesting})
+	assert.True(t, errors.Is(err, ErrSenderTypeMismatch))
+
+	other := &albatross.BasicAccount{AccountBase: albatross.AccountBase{Address: tx.Recipient, Balance: 1000000}}
+	err = tx.Validate(&ValidationContext{Sender: other})
+	assert.Error(t, err)
+	assert.NotNil(t, err.(*ValidationError).Field("Sender"))
+}
+
+func TestValidateVestingSender(t *testing.T) {
+	tx := testTransaction(t)
+	tx.SenderType = AccountTypeVesting
+
+	// Half of the contract has vested, the other half is still locked
+	vesting := &albatross.VestingAccount{
+		AccountBase:        albatross.AccountBase{Address: tx.Sender, Balance: 200000},
+		VestingStart:       0,
+		VestingTimeStep:    1000,
+		VestingStepAmount:  100000,
+		VestingTotalAmount: 200000,
+	}
+	ctx := &ValidationContext{Sender: vesting, Time: time.UnixMilli(1000)}
+	assert.True(t, errors.Is(tx.Validate(ctx), ErrInsufficientBalance), "Locked funds cannot be spent")
+
+	ctx.Time = time.UnixMilli(2000)
+	assert.NoError(t, tx.Validate(ctx))
+}
+
+func TestValidateSignalingTransaction(t *testing.T) {
+	tx := testTransaction(t)
+	tx.Value = 0
+	tx.RecipientType = AccountTypeStaking
+	tx.Flags = FlagSignaling
+	assert.NoError(t, tx.Validate(nil), "Signaling transactions have no value")
+
+	for _, recipientType := range []AccountType{AccountTypeVesting, AccountTypeHTLC, AccountTypeStaking} {
+		tx.RecipientType = recipientType
+		tx.Flags = 0
+		assert.True(t, errors.Is(tx.Validate(nil), ErrZeroValue), "Only signaling transactions can have no value")
+	}
+}
+
+func TestValidateWithNode(t *testing.T) {
+	tx := testTransaction(t)
+
+	assert.NoError(t, ValidateWithNode(&testValidationSource{account: testBasicAccount(t, 200000)}, tx))
+
+	err := ValidateWithNode(&testValidationSource{account: testBasicAccount(t, 0)}, tx)
+	assert.True(t, errors.Is(err, ErrInsufficientBalance))
+}
+
+func TestValidateWithNodeVestingSender(t *testing.T) {
+	tx := testTransaction(t)
+	tx.SenderType = AccountTypeVesting
+	tx.Fee = 169 // Vesting senders need the extended format
+
+	// The second step of the contract unlocks at 2000 ms
+	vesting := &albatross.VestingAccount{
+		AccountBase:        albatross.AccountBase{Address: tx.Sender, Balance: 200000},
+		VestingStart:       0,
+		VestingTimeStep:    1000,
+		VestingStepAmount:  100000,
+		VestingTotalAmount: 200000,
+	}
+
+	err := ValidateWithNode(&testValidationSource{account: vesting, timestamp: 1999}, tx)
+	assert.True(t, errors.Is(err, ErrInsufficientBalance), "The head block is just before the unlock")
+
+	assert.NoError(t, ValidateWithNode(&testValidationSource{account: vesting, timestamp: 2000}, tx))
+}
@@ -0,0 +1,80 @@
+package albatross
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrTransactionExpired is returned for validity start heights outside the validity window
+	ErrTransactionExpired = errors.New("transaction expired")
+	// ErrValidityStartHeightInFuture is returned for validity start heights after the next block
+	ErrValidityStartHeightInFuture = errors.New("validity start height is in the future")
+)
+
+// TransactionExpiresAt returns the last block a transaction with the given validity start height
+// can be included in
+func (p *Policy) TransactionExpiresAt(validityStartHeight int) int {
+	return validityStartHeight + p.TransactionValidityWindow - 1
+}
+
+// IsTransactionExpired returns whether a transaction with the given validity start height can no
+// longer be included in the block following head
+func (p *Policy) IsTransactionExpired(validityStartHeight, head int) bool {
+	return head+1 > p.TransactionExpiresAt(validityStartHeight)
+}
+
+// BlocksUntilExpiry returns the number of blocks following head that can still include a
+// transaction with the given validity start height, zero if it has expired
+func (p *Policy) BlocksUntilExpiry(validityStartHeight, head int) int {
+	if p.IsTransactionExpired(validityStartHeight, head) {
+		return 0
+	}
+	return p.TransactionExpiresAt(validityStartHeight) - head
+}
+
+// ValidateValidityStartHeight returns an error if a transaction with the given validity start
+// height cannot be included in the block following head
+func (p *Policy) ValidateValidityStartHeight(validityStartHeight, head int) error {
+	if validityStartHeight > head+1 {
+		return fmt.Errorf("%w: %d is after the next block %d", ErrValidityStartHeightInFuture, validityStartHeight, head+1)
+	}
+	if p.IsTransactionExpired(validityStartHeight, head) {
+		return fmt.Errorf("%w: %d expired at block %d, head is %d", ErrTransactionExpired,
+			validityStartHeight, p.TransactionExpiresAt(validityStartHeight), head)
+	}
+	return nil
+}
+
+// GetValidityStartHeight returns the current head of the node plus an optional offset as validity
+// start height. A negative offset tolerates other nodes lagging behind, at the cost of a shorter
+// validity window. Positive offsets are rejected as the transaction would not be valid yet.
+func (h *HttpClient) GetValidityStartHeight(offset ...int) (int, error) {
+	var blockOffset int
+	if len(offset) > 0 {
+		blockOffset = offset[0]
+	}
+	if blockOffset > 0 {
+		return 0, fmt.Errorf("%w: offset %d", ErrValidityStartHeightInFuture, blockOffset)
+	}
+
+	head, err := h.GetBlockNumber()
+	if err != nil {
+		return 0, err
+	}
+
+	if head+blockOffset < 0 {
+		return 0, fmt.Errorf("offset %d exceeds head %d", blockOffset, head)
+	}
+	return head + blockOffset, nil
+}
+
+// IsTransactionExpired returns whether a pending transaction with the given validity start height
+// can no longer be included in the chain of the node
+func (h *HttpClient) IsTransactionExpired(policy *Policy, validityStartHeight int) (bool, error) {
+	head, err := h.GetBlockNumber()
+	if err != nil {
+		return false, err
+	}
+	return policy.IsTransactionExpired(validityStartHeight, head), nil
+}
@@ -0,0 +1,62 @@
+package albatross
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateValidityStartHeight(t *testing.T) {
+	// With a window of 24 blocks a transaction valid from block 100 can be included up to block 123
+	assert.Equal(t, 123, testPolicy.TransactionExpiresAt(100))
+
+	assert.NoError(t, testPolicy.ValidateValidityStartHeight(100, 99))
+	assert.NoError(t, testPolicy.ValidateValidityStartHeight(100, 100))
+	assert.NoError(t, testPolicy.ValidateValidityStartHeight(100, 122))
+
+	err := testPolicy.ValidateValidityStartHeight(100, 123)
+	assert.True(t, errors.Is(err, ErrTransactionExpired))
+
+	err = testPolicy.ValidateValidityStartHeight(102, 100)
+	assert.True(t, errors.Is(err, ErrValidityStartHeightInFuture))
+}
+
+func TestBlocksUntilExpiry(t *testing.T) {
+	assert.Equal(t, 23, testPolicy.BlocksUntilExpiry(100, 100))
+	assert.Equal(t, 1, testPolicy.BlocksUntilExpiry(100, 122))
+	assert.Equal(t, 0, testPolicy.BlocksUntilExpiry(100, 123))
+	assert.False(t, testPolicy.IsTransactionExpired(100, 122))
+	assert.True(t, testPolicy.IsTransactionExpired(100, 123))
+}
+
+func TestGetValidityStartHeight(t *testing.T) {
+	client := newMockClient(t, "getBlockNumber", `[]`, `1234`)
+	height, err := client.GetValidityStartHeight()
+	assert.NoError(t, err)
+	assert.Equal(t, 1234, height)
+
+	client = newMockClient(t, "getBlockNumber", `[]`, `1234`)
+	height, err = client.GetValidityStartHeight(-5)
+	assert.NoError(t, err)
+	assert.Equal(t, 1229, height)
+
+	client = newMockClient(t, "getBlockNumber", `[]`, `1234`)
+	_, err = client.GetValidityStartHeight(-2000)
+	assert.Error(t, err)
+
+	_, err = client.GetValidityStartHeight(1)
+	assert.True(t, errors.Is(err, ErrValidityStartHeightInFuture))
+}
+
+func TestIsTransactionExpired(t *testing.T) {
+	client := newMockClient(t, "getBlockNumber", `[]`, `1234`)
+	expired, err := client.IsTransactionExpired(testPolicy, 1200)
+	assert.NoError(t, err)
+	assert.True(t, expired)
+
+	client = newMockClient(t, "getBlockNumber", `[]`, `1234`)
+	expired, err = client.IsTransactionExpired(testPolicy, 1220)
+	assert.NoError(t, err)
+	assert.False(t, expired)
+}
@@ -0,0 +1,66 @@
+package albatross
+
+// ZKPState is the state of the zero-knowledge proof of the chain held by a node.
+// The proof covers the chain up to the election block it was produced for.
+type ZKPState struct {
+	LatestHeaderHash  Hash     `json:"latestHeaderHash"`
+	LatestBlockNumber int      `json:"latestBlockNumber"`
+	LatestProof       HexBytes `json:"latestProof,omitempty"` // Empty if no proof has been produced yet
+}
+
+// HasProof returns whether the node holds a proof
+func (z *ZKPState) HasProof() bool {
+	return len(z.LatestProof) > 0
+}
+
+// ZKPLag describes how far the proof of a node lags behind the head of the chain
+type ZKPLag struct {
+	ProofBlockNumber int // Block number the proof was produced for
+	HeadBlockNumber  int // Block number of the head of the chain
+	Blocks           int // Number of blocks between the proof and the head
+	Epochs           int // Number of epochs between the proof and the head
+}
+
+// InSync returns whether the proof covers the latest election block before the head
+func (l *ZKPLag) InSync() bool {
+	return l.Epochs <= 1
+}
+
+// LagBehind returns how far the proof lags behind the given head block
+func (z *ZKPState) LagBehind(head Block, policy *Policy) *ZKPLag {
+	headNumber := head.Header().Number
+	lag := &ZKPLag{
+		ProofBlockNumber: z.LatestBlockNumber,
+		HeadBlockNumber:  headNumber,
+	}
+
+	if headNumber > z.LatestBlockNumber {
+		lag.Blocks = headNumber - z.LatestBlockNumber
+		lag.Epochs = policy.EpochAt(headNumber) - policy.EpochAt(z.LatestBlockNumber)
+	}
+
+	return lag
+}
+
+// GetZKPState retrieves the state of the zero-knowledge proof held by the node
+func (h *HttpClient) GetZKPState() (*ZKPState, error) {
+	req := NewRPCRequest("getZkpState")
+
+	return callAndUnwrapToPointer[ZKPState](h, req)
+}
+
+// GetZKPLag retrieves the proof state and the latest block of the node and reports
+// how far the proof lags behind the head of the chain
+func (h *HttpClient) GetZKPLag(policy *Policy) (*ZKPLag, error) {
+	state, err := h.GetZKPState()
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := h.GetLatestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return state.LagBehind(head, policy), nil
+}
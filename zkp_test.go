@@ -0,0 +1,34 @@
+package albatross
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetZKPState(t *testing.T) {
+	mockResult := `{"latestHeaderHash":"` + testHashA + `","latestBlockNumber":24,"latestProof":"ccdd"}`
+	client := newMockClient(t, "getZkpState", `[]`, mockResult)
+
+	state, err := client.GetZKPState()
+	assert.NoError(t, err)
+	assert.Equal(t, &ZKPState{LatestHeaderHash: mustParseHash(t, testHashA), LatestBlockNumber: 24, LatestProof: HexBytes{0xcc, 0xdd}}, state)
+	assert.True(t, state.HasProof())
+}
+
+func TestZKPLagBehind(t *testing.T) {
+	state := &ZKPState{LatestBlockNumber: 12}
+
+	lag := state.LagBehind(&MicroBlock{BlockHeader: BlockHeader{Number: 20}}, testPolicy)
+	assert.Equal(t, &ZKPLag{ProofBlockNumber: 12, HeadBlockNumber: 20, Blocks: 8, Epochs: 1}, lag)
+	assert.True(t, lag.InSync())
+
+	lag = state.LagBehind(&MicroBlock{BlockHeader: BlockHeader{Number: 40}}, testPolicy)
+	assert.Equal(t, 28, lag.Blocks)
+	assert.Equal(t, 3, lag.Epochs)
+	assert.False(t, lag.InSync())
+
+	lag = state.LagBehind(&MicroBlock{BlockHeader: BlockHeader{Number: 12}}, testPolicy)
+	assert.Equal(t, 0, lag.Blocks)
+	assert.True(t, lag.InSync())
+}